@@ -1,7 +1,47 @@
 package main
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/calender/_configuration"
+)
 
 func main() {
-	fmt.Println("Hello World")
+	cfg := _configuration.NewAPIConfiguration()
+
+	server := &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           cfg.Handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go cfg.ChangeListener.Run(ctx)
+
+	go func() {
+		slog.Info("starting server", "addr", cfg.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to start server", "error", err)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to shutdown server", "error", err)
+		os.Exit(1)
+	}
 }
@@ -12,7 +12,7 @@ func main() {
 		OutPath:       "internal/calender/infrastructure/datasource/db/query",
 		ModelPkgPath:  "internal/calender/infrastructure/datasource/db/entity",
 		Mode:          gen.WithDefaultQuery,
-		FieldNullable: false,
+		FieldNullable: true,
 	})
 
 	g.UseDB(cfg.DB)
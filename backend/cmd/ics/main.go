@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/calender/_configuration"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+const usage = `usage: ics <command> [flags]
+
+commands:
+  export  write national holidays and closed days as an iCalendar file
+  import  read closed days, or working-day overrides with -overrides, from iCalendar files
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = export(os.Args[2:])
+	case "import":
+		err = importClosedDays(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		fmt.Fprintln(os.Stderr, err)
+		usageErr.fs.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// usageError is an invalid command line, reported along with the usage of the command
+type usageError struct {
+	fs  *flag.FlagSet
+	err error
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+func export(args []string) error {
+	now := time.Now()
+	period := usecase.DefaultFeedPeriod(now)
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	calendarID := fs.String("calendar", model.DefaultCalendarID, "calendar id")
+	from := fs.String("from", period.Begin.Format("2006-01-02"), "first date (YYYY-MM-DD)")
+	to := fs.String("to", period.End.Format("2006-01-02"), "last date (YYYY-MM-DD)")
+	category := fs.String("category", "", "comma separated categories to export, e.g. national_holiday,closed_day,working_day_override or substitute")
+	lang := fs.String("lang", string(model.DefaultLanguage), "language of the event summaries, ja or en")
+	output := fs.String("o", "", "output file, stdout when empty")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: ics export [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var err error
+	if period.Begin, err = time.ParseInLocation("2006-01-02", *from, timex.JST); err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	if period.End, err = time.ParseInLocation("2006-01-02", *to, timex.JST); err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	kinds, categories, err := usecase.ParseFeedCategories(*category)
+	if err != nil {
+		return &usageError{fs: fs, err: fmt.Errorf("invalid -category: %w", err)}
+	}
+	language, err := model.ParseLanguage(*lang)
+	if err != nil {
+		return &usageError{fs: fs, err: fmt.Errorf("invalid -lang: %w", err)}
+	}
+
+	cfg := _configuration.NewICSConfiguration()
+	feed, err := cfg.Feed.Feed(context.Background(), usecase.FeedQuery{
+		CalendarID: *calendarID,
+		Period:     period,
+		Kinds:      kinds,
+		Categories: categories,
+		Language:   language,
+	}, now)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer func(f *os.File) {
+			_ = f.Close()
+		}(f)
+		w = f
+	}
+
+	return feed.Encode(w)
+}
+
+func importClosedDays(args []string) error {
+	now := time.Now()
+
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	calendarID := fs.String("calendar", model.DefaultCalendarID, "calendar id")
+	until := fs.String("until", timex.Date(now.Year()+1, time.December, 31).Format("2006-01-02"), "last date recurring events are expanded to (YYYY-MM-DD)")
+	dryRun := fs.Bool("dry-run", false, "print the days without saving them")
+	overrides := fs.Bool("overrides", false, "import the events as working-day overrides, dates worked despite holidays and weekends")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: ics import [flags] <file.ics>...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return &usageError{fs: fs, err: errors.New("no iCalendar files given")}
+	}
+
+	horizon, err := time.ParseInLocation("2006-01-02", *until, timex.JST)
+	if err != nil {
+		return fmt.Errorf("invalid -until: %w", err)
+	}
+	// Include occurrences starting at any time of the last day
+	horizon = horizon.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+	cfg := _configuration.NewICSConfiguration()
+	for _, name := range fs.Args() {
+		days, err := importFile(cfg, name, usecase.ImportQuery{
+			CalendarID: *calendarID,
+			Horizon:    horizon,
+			DryRun:     *dryRun,
+		}, *overrides)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		if *dryRun {
+			for _, d := range days {
+				fmt.Printf("%s\t%s\n", d.date.Format("2006-01-02"), d.summary)
+			}
+			continue
+		}
+		if *overrides {
+			fmt.Fprintf(os.Stderr, "%s: imported %d working-day overrides\n", name, len(days))
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s: imported %d closed days\n", name, len(days))
+	}
+	return nil
+}
+
+// importedDay is a closed day or working-day override read from a file
+type importedDay struct {
+	date    time.Time
+	summary string
+}
+
+func importFile(cfg *_configuration.ICSConfiguration, name string, q usecase.ImportQuery, overrides bool) ([]importedDay, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+
+	var days []importedDay
+	if overrides {
+		imported, err := cfg.Import.ImportOverrides(context.Background(), f, q)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range imported {
+			days = append(days, importedDay{date: o.Date, summary: o.Summary})
+		}
+		return days, nil
+	}
+
+	imported, err := cfg.Import.Import(context.Background(), f, q)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range imported {
+		days = append(days, importedDay{date: d.Date, summary: d.Summary})
+	}
+	return days, nil
+}
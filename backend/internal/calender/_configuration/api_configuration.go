@@ -0,0 +1,29 @@
+package _configuration
+
+import (
+	"net/http"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource"
+)
+
+type APIConfiguration struct {
+	Addr           string
+	Handler        http.Handler
+	ChangeListener *datasource.ChangeListener
+}
+
+func NewAPIConfiguration() *APIConfiguration {
+	i := injector
+
+	var cfg APIConfiguration
+	if err := i.Invoke(func(opts *option, instance http.Handler, listener *datasource.ChangeListener) {
+		cfg.Addr = opts.Addr
+		cfg.Handler = instance
+		cfg.ChangeListener = listener
+	}); err != nil {
+		panic(xerrors.Errorf("failed to resolving dependencies a http handler: %w", err))
+	}
+
+	return &cfg
+}
@@ -9,9 +9,15 @@ import (
 
 type envConfig struct {
 	BusinessDataSource businessDataSource `envPrefix:"BUSINESS_DB_"`
+	Server             server             `envPrefix:"SERVER_"`
 	TimeZone           string             `env:"TZ,notEmpty"`
 }
 
+type server struct {
+	Host string `env:"HOST" envDefault:""`
+	Port string `env:"PORT" envDefault:"8080"`
+}
+
 type businessDataSource struct {
 	Host     string `env:"HOST,notEmpty"`
 	Port     string `env:"PORT,notEmpty"`
@@ -36,6 +42,10 @@ func (e envConfig) dsn() string {
 	)
 }
 
+func (e envConfig) addr() string {
+	return fmt.Sprintf("%s:%s", e.Server.Host, e.Server.Port)
+}
+
 func envParse() envConfig {
 	var e envConfig
 	if err := env.Parse(&e); err != nil {
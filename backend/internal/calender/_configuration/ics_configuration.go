@@ -0,0 +1,25 @@
+package _configuration
+
+import (
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+)
+
+type ICSConfiguration struct {
+	Feed   *usecase.CalendarFeedUsecase
+	Import *usecase.ClosedDayImportUsecase
+}
+
+func NewICSConfiguration() *ICSConfiguration {
+	i := injector
+
+	var cfg ICSConfiguration
+	if err := i.Invoke(func(feed *usecase.CalendarFeedUsecase, importer *usecase.ClosedDayImportUsecase) {
+		cfg.Feed = feed
+		cfg.Import = importer
+	}); err != nil {
+		panic(xerrors.Errorf("failed to resolving dependencies a ics usecases: %w", err))
+	}
+
+	return &cfg
+}
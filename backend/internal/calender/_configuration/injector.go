@@ -1,8 +1,14 @@
 package _configuration
 
 import (
+	"net/http"
+
 	"go.uber.org/dig"
 	"gorm.io/gorm"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+	"net.bright-room.dev/calender-api/internal/calender/presentation/handler"
 )
 
 var injector *dig.Container
@@ -11,7 +17,47 @@ func init() {
 	injector = dig.New()
 	opts := createOption()
 
-	if err := injector.Provide(func() *gorm.DB { return opts.DB }); err != nil {
-		panic(err)
+	providers := []interface{}{
+		func() *option { return opts },
+		func() *gorm.DB { return opts.DB },
+		query.Use,
+
+		// Repositories
+		datasource.NewCacheRegistry,
+		datasource.NewCalendarRepository,
+		datasource.NewBusinessCalendarRepository,
+		datasource.NewBusinessHoursRepository,
+		datasource.NewClosedDayRepository,
+		datasource.NewWorkingDayOverrideRepository,
+		datasource.NewBusinessDayIndexRepository,
+		datasource.NewBusinessDaySetRepository,
+		func(opts *option, caches *datasource.CacheRegistry) *datasource.ChangeListener {
+			return datasource.NewChangeListener(opts.DSN, caches)
+		},
+
+		// Usecases
+		usecase.NewCalendarListUsecase,
+		usecase.NewCalendarViewUsecase,
+		usecase.NewCalendarFeedUsecase,
+		usecase.NewClosedDayImportUsecase,
+		usecase.NewBusinessHoursUsecase,
+		usecase.NewHolidayListUsecase,
+		usecase.NewBusinessDayUsecase,
+
+		// Handlers
+		handler.NewCalendarHandler,
+		handler.NewFeedHandler,
+		handler.NewBusinessHoursHandler,
+		handler.NewHolidayHandler,
+		handler.NewBusinessDayHandler,
+		func(calendar *handler.CalendarHandler, feed *handler.FeedHandler, hours *handler.BusinessHoursHandler, holidays *handler.HolidayHandler, businessDays *handler.BusinessDayHandler) http.Handler {
+			return handler.NewRouter(calendar, feed, hours, holidays, businessDays)
+		},
+	}
+
+	for _, provider := range providers {
+		if err := injector.Provide(provider); err != nil {
+			panic(err)
+		}
 	}
 }
@@ -6,7 +6,9 @@ import (
 )
 
 type option struct {
-	DB *gorm.DB
+	DB   *gorm.DB
+	DSN  string
+	Addr string
 }
 
 func createOption() *option {
@@ -21,6 +23,8 @@ func createOption() *option {
 	}
 
 	return &option{
-		DB: db,
+		DB:   db,
+		DSN:  e.dsn(),
+		Addr: e.addr(),
 	}
 }
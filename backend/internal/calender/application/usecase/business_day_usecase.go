@@ -0,0 +1,273 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+const (
+	// maxBusinessDaySearchDays bounds how far from a date a business day is searched for
+	maxBusinessDaySearchDays = 366
+	// maxSchedulePeriodDays bounds the period a schedule is generated for
+	maxSchedulePeriodDays = 366 * 30
+)
+
+// MaxPaymentTransactions bounds the transactions of a single payment term calculation
+const MaxPaymentTransactions = 10000
+
+// MaxBusinessDaysToAdd bounds the business days added to or subtracted from a date, about twenty years of them
+const MaxBusinessDaysToAdd = 5000
+
+// ScheduleQuery describes a monthly schedule of a calendar
+type ScheduleQuery struct {
+	CalendarID string
+	Period     timex.TimeRange // Period of the unadjusted dates
+	Schedule   model.MonthlySchedule
+}
+
+// PaymentTermQuery describes the transactions to calculate the closing and payment dates of
+type PaymentTermQuery struct {
+	CalendarID   string
+	Term         model.PaymentTerm
+	Transactions []time.Time // Transaction dates
+}
+
+// NthBusinessDayQuery selects the nth business day of every month of a range
+type NthBusinessDayQuery struct {
+	CalendarID string
+	Months     timex.TimeRange // Months from the month of Begin to the month of End
+	N          int             // 1 for the first business day, -1 for the last one
+}
+
+// MonthBusinessDay is the nth business day of a month
+type MonthBusinessDay struct {
+	Year  int
+	Month time.Month
+	Date  time.Time // Zero when the month has fewer business days
+}
+
+// BusinessDayUsecase answers business-day date arithmetic such as rolling dates to business days
+type BusinessDayUsecase struct {
+	calendars repository.BusinessCalendarRepository
+	indexes   repository.BusinessDayIndexRepository
+	sets      repository.BusinessDaySetRepository
+}
+
+// NewBusinessDayUsecase creates a BusinessDayUsecase
+func NewBusinessDayUsecase(calendars repository.BusinessCalendarRepository, indexes repository.BusinessDayIndexRepository, sets repository.BusinessDaySetRepository) *BusinessDayUsecase {
+	return &BusinessDayUsecase{calendars: calendars, indexes: indexes, sets: sets}
+}
+
+// IsBusinessDay reports whether the date is a business day of the calendar, from the cached business days of its year
+func (u *BusinessDayUsecase) IsBusinessDay(ctx context.Context, calendarID string, date time.Time) (bool, error) {
+	date = timex.DateOf(date)
+	days, err := u.sets.FindByYear(ctx, calendarID, date.Year())
+	if err != nil {
+		return false, xerrors.Errorf("failed to load business days: %w", err)
+	}
+	return days.IsBusinessDay(date)
+}
+
+// Roll adjusts the date to a business day of the calendar by the convention
+func (u *BusinessDayUsecase) Roll(ctx context.Context, calendarID string, date time.Time, convention model.RollConvention) (time.Time, error) {
+	var rolled time.Time
+	err := u.withCalendar(ctx, calendarID, timex.TimeRange{Begin: date, End: date}, func(calendar *model.BusinessCalendar) error {
+		var err error
+		rolled, err = model.Roll(date, convention, calendar)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("failed to roll date: %w", err)
+	}
+	return rolled, nil
+}
+
+// Schedule returns the dates of the monthly schedule in the period, adjusted to business days of the calendar
+func (u *BusinessDayUsecase) Schedule(ctx context.Context, q ScheduleQuery) ([]model.ScheduledDate, error) {
+	if timex.DateOf(q.Period.End).Sub(timex.DateOf(q.Period.Begin)) > maxSchedulePeriodDays*timex.DAY {
+		return nil, xerrors.Errorf("period is longer than %d days: %w", maxSchedulePeriodDays, model.ErrPeriodTooLong)
+	}
+
+	var dates []model.ScheduledDate
+	err := u.withCalendar(ctx, q.CalendarID, q.Period, func(calendar *model.BusinessCalendar) error {
+		var err error
+		dates, err = q.Schedule.Dates(q.Period.Begin, q.Period.End, calendar)
+		return err
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to generate schedule: %w", err)
+	}
+	return dates, nil
+}
+
+// PaymentDates returns the closing and payment dates of the transactions under the payment term, in the order of the transactions.
+// The calendar is loaded once for all of them.
+func (u *BusinessDayUsecase) PaymentDates(ctx context.Context, q PaymentTermQuery) ([]model.PaymentDates, error) {
+	if err := q.Term.Validate(); err != nil {
+		return nil, err
+	}
+	if len(q.Transactions) == 0 {
+		return nil, nil
+	}
+	if len(q.Transactions) > MaxPaymentTransactions {
+		return nil, xerrors.Errorf("more than %d transactions: %w", MaxPaymentTransactions, model.ErrPeriodTooLong)
+	}
+
+	first, last := q.Transactions[0], q.Transactions[0]
+	for _, t := range q.Transactions {
+		if t.Before(first) {
+			first = t
+		}
+		if t.After(last) {
+			last = t
+		}
+	}
+	// Payments are made in the month after the closing month of the last transaction at the latest, plus the offset
+	period := timex.TimeRange{Begin: first, End: last.AddDate(0, q.Term.PaymentMonthOffset+1, 0)}
+	if timex.DateOf(period.End).Sub(timex.DateOf(period.Begin)) > maxSchedulePeriodDays*timex.DAY {
+		return nil, xerrors.Errorf("transactions span more than %d days: %w", maxSchedulePeriodDays, model.ErrPeriodTooLong)
+	}
+
+	dates := make([]model.PaymentDates, 0, len(q.Transactions))
+	err := u.withCalendar(ctx, q.CalendarID, period, func(calendar *model.BusinessCalendar) error {
+		dates = dates[:0]
+		for _, t := range q.Transactions {
+			d, err := q.Term.Dates(t, calendar)
+			if err != nil {
+				return err
+			}
+			dates = append(dates, d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to calculate payment dates: %w", err)
+	}
+	return dates, nil
+}
+
+// NthBusinessDays returns the nth business day of every month of the range in month order
+func (u *BusinessDayUsecase) NthBusinessDays(ctx context.Context, q NthBusinessDayQuery) ([]MonthBusinessDay, error) {
+	if q.N == 0 {
+		return nil, xerrors.Errorf("n must not be zero")
+	}
+	if timex.DateOf(q.Months.End).Sub(timex.DateOf(q.Months.Begin)) > maxSchedulePeriodDays*timex.DAY {
+		return nil, xerrors.Errorf("period is longer than %d days: %w", maxSchedulePeriodDays, model.ErrPeriodTooLong)
+	}
+
+	var days []MonthBusinessDay
+	err := u.withCalendar(ctx, q.CalendarID, q.Months, func(calendar *model.BusinessCalendar) error {
+		days = days[:0]
+		end := timex.Date(q.Months.End.Year(), q.Months.End.Month(), 1)
+		for month := timex.Date(q.Months.Begin.Year(), q.Months.Begin.Month(), 1); !month.After(end); month = month.AddDate(0, 1, 0) {
+			date, err := calendar.NthBusinessDay(month.Year(), month.Month(), q.N)
+			if err != nil && !errors.Is(err, model.ErrNoSuchBusinessDay) {
+				return err
+			}
+			days = append(days, MonthBusinessDay{Year: month.Year(), Month: month.Month(), Date: date})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find business days: %w", err)
+	}
+	return days, nil
+}
+
+// MonthOrdinal returns the position of the date among the business days of its month in the calendar
+func (u *BusinessDayUsecase) MonthOrdinal(ctx context.Context, calendarID string, date time.Time) (model.MonthOrdinal, error) {
+	var ordinal model.MonthOrdinal
+	err := u.withCalendar(ctx, calendarID, timex.TimeRange{Begin: date, End: date}, func(calendar *model.BusinessCalendar) error {
+		var err error
+		ordinal, err = calendar.MonthOrdinal(date)
+		return err
+	})
+	if err != nil {
+		return model.MonthOrdinal{}, xerrors.Errorf("failed to find business day ordinal: %w", err)
+	}
+	return ordinal, nil
+}
+
+// AddBusinessDays returns the nth business day of the calendar after the date, or before it when n is negative
+func (u *BusinessDayUsecase) AddBusinessDays(ctx context.Context, calendarID string, date time.Time, n int) (time.Time, error) {
+	if n > MaxBusinessDaysToAdd || n < -MaxBusinessDaysToAdd {
+		return time.Time{}, xerrors.Errorf("more than %d business days: %w", MaxBusinessDaysToAdd, model.ErrPeriodTooLong)
+	}
+
+	// Start with roughly twice the days needed, and widen the period until it is enough
+	for days := min(2*abs(n)+31, maxSchedulePeriodDays); ; days = min(days*2, maxSchedulePeriodDays) {
+		index, err := u.indexes.FindByPeriod(ctx, calendarID, timex.TimeRange{
+			Begin: timex.DateOf(date).AddDate(0, 0, -days),
+			End:   timex.DateOf(date).AddDate(0, 0, days),
+		})
+		if err != nil {
+			return time.Time{}, xerrors.Errorf("failed to load business day index: %w", err)
+		}
+
+		result, err := index.AddBusinessDays(date, n)
+		if errors.Is(err, model.ErrOutOfPeriod) && days < maxSchedulePeriodDays {
+			continue
+		}
+		if err != nil {
+			return time.Time{}, xerrors.Errorf("failed to add business days: %w", err)
+		}
+		return result, nil
+	}
+}
+
+// BusinessDaysBetween returns the number of business days of the calendar after a up to and including b,
+// negative when b is before a
+func (u *BusinessDayUsecase) BusinessDaysBetween(ctx context.Context, calendarID string, a, b time.Time) (int, error) {
+	period := timex.TimeRange{Begin: a, End: b}
+	if b.Before(a) {
+		period = timex.TimeRange{Begin: b, End: a}
+	}
+	if timex.DateOf(period.End).Sub(timex.DateOf(period.Begin)) > maxSchedulePeriodDays*timex.DAY {
+		return 0, xerrors.Errorf("period is longer than %d days: %w", maxSchedulePeriodDays, model.ErrPeriodTooLong)
+	}
+
+	index, err := u.indexes.FindByPeriod(ctx, calendarID, period)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to load business day index: %w", err)
+	}
+	days, err := index.BusinessDaysBetween(a, b)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to count business days: %w", err)
+	}
+	return days, nil
+}
+
+// withCalendar calls f with the calendar loaded for the months of the period and a margin around them,
+// widening the margin while f needs dates outside it
+func (u *BusinessDayUsecase) withCalendar(ctx context.Context, calendarID string, period timex.TimeRange, f func(*model.BusinessCalendar) error) error {
+	begin := timex.Date(period.Begin.Year(), period.Begin.Month(), 1)
+	end := timex.Date(period.End.Year(), period.End.Month()+1, 0)
+	for days := 31; ; days *= 2 {
+		calendar, err := u.calendars.FindByPeriod(ctx, calendarID, timex.TimeRange{
+			Begin: begin.AddDate(0, 0, -days),
+			End:   end.AddDate(0, 0, days),
+		})
+		if err != nil {
+			return xerrors.Errorf("failed to load calendar: %w", err)
+		}
+
+		err = f(calendar)
+		if errors.Is(err, model.ErrOutOfPeriod) && days < maxBusinessDaySearchDays {
+			continue
+		}
+		return err
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
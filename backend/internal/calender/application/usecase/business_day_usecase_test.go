@@ -0,0 +1,162 @@
+package usecase_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type fakeBusinessDayIndexRepository struct {
+	calendars *fakeBusinessCalendarRepository
+}
+
+func (r *fakeBusinessDayIndexRepository) FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessDayIndex, error) {
+	calendar, err := r.calendars.FindByPeriod(ctx, calendarID, period)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewBusinessDayIndex(calendar), nil
+}
+
+func (r *fakeBusinessDayIndexRepository) Invalidate() {}
+
+type fakeBusinessDaySetRepository struct {
+	calendars *fakeBusinessCalendarRepository
+}
+
+func (r *fakeBusinessDaySetRepository) FindByYear(ctx context.Context, calendarID string, year int) (*model.YearBusinessDays, error) {
+	calendar, err := r.calendars.FindByPeriod(ctx, calendarID, timex.TimeRange{Begin: timex.Date(year, 1, 1), End: timex.Date(year, 12, 31)})
+	if err != nil {
+		return nil, err
+	}
+	return model.NewYearBusinessDays(calendar, year)
+}
+
+func (r *fakeBusinessDaySetRepository) Invalidate() {}
+
+func TestBusinessDayUsecase(t *testing.T) {
+	repo := &fakeBusinessCalendarRepository{
+		nationalHolidays: []model.NationalHoliday{
+			{Date: timex.Date(2025, 5, 5), Summary: "こどもの日"},
+			{Date: timex.Date(2025, 5, 6), Summary: "休日"},
+		},
+		closedDays: map[string][]model.ClosedDay{model.DefaultCalendarID: nil},
+	}
+	u := usecase.NewBusinessDayUsecase(repo, &fakeBusinessDayIndexRepository{calendars: repo}, &fakeBusinessDaySetRepository{calendars: repo})
+
+	t.Run("営業日かどうかが判定される", func(t *testing.T) {
+		holiday, err := u.IsBusinessDay(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 6))
+		assert.NoError(t, err)
+		businessDay, err := u.IsBusinessDay(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 7))
+		assert.NoError(t, err)
+
+		assert.False(t, holiday)
+		assert.True(t, businessDay)
+	})
+
+	t.Run("翌営業日に調整される", func(t *testing.T) {
+		actual, err := u.Roll(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 3), model.RollFollowing)
+
+		assert.NoError(t, err)
+		assert.Equal(t, timex.Date(2025, 5, 7), actual)
+	})
+
+	t.Run("毎月25日の支払日が調整される", func(t *testing.T) {
+		actual, err := u.Schedule(context.Background(), usecase.ScheduleQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 6, 30)},
+			Schedule:   model.MonthlySchedule{Day: 25, Convention: model.RollPreceding},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ScheduledDate{
+			{Unadjusted: timex.Date(2025, 5, 25), Adjusted: timex.Date(2025, 5, 23)},
+			{Unadjusted: timex.Date(2025, 6, 25), Adjusted: timex.Date(2025, 6, 25)},
+		}, actual)
+	})
+
+	t.Run("取引ごとの締め日と支払日が計算される", func(t *testing.T) {
+		actual, err := u.PaymentDates(context.Background(), usecase.PaymentTermQuery{
+			CalendarID:   model.DefaultCalendarID,
+			Term:         model.PaymentTerm{ClosingDay: 31, PaymentMonthOffset: 1, PaymentDay: 5, Adjustment: model.RollFollowing},
+			Transactions: []time.Time{timex.Date(2025, 5, 1), timex.Date(2025, 4, 10)},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.PaymentDates{
+			{Transaction: timex.Date(2025, 5, 1), Closing: timex.Date(2025, 5, 31), UnadjustedPayment: timex.Date(2025, 6, 5), Payment: timex.Date(2025, 6, 5)},
+			{Transaction: timex.Date(2025, 4, 10), Closing: timex.Date(2025, 4, 30), UnadjustedPayment: timex.Date(2025, 5, 5), Payment: timex.Date(2025, 5, 7)},
+		}, actual)
+	})
+
+	t.Run("各月の月末最終営業日が返される", func(t *testing.T) {
+		actual, err := u.NthBusinessDays(context.Background(), usecase.NthBusinessDayQuery{
+			CalendarID: model.DefaultCalendarID,
+			Months:     timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 6, 1)},
+			N:          -1,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []usecase.MonthBusinessDay{
+			{Year: 2025, Month: time.May, Date: timex.Date(2025, 5, 30)},
+			{Year: 2025, Month: time.June, Date: timex.Date(2025, 6, 30)},
+		}, actual)
+	})
+
+	t.Run("営業日が足りない月は日付が空になる", func(t *testing.T) {
+		actual, err := u.NthBusinessDays(context.Background(), usecase.NthBusinessDayQuery{
+			CalendarID: model.DefaultCalendarID,
+			Months:     timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 5, 1)},
+			N:          21,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []usecase.MonthBusinessDay{{Year: 2025, Month: time.May}}, actual)
+	})
+
+	t.Run("月内の営業日の順番が返される", func(t *testing.T) {
+		actual, err := u.MonthOrdinal(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 7))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, actual.Ordinal)
+	})
+
+	t.Run("営業日を足し引きできる", func(t *testing.T) {
+		actual, err := u.AddBusinessDays(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 2), 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, timex.Date(2025, 5, 8), actual)
+	})
+
+	t.Run("100営業日前が求められる", func(t *testing.T) {
+		actual, err := u.AddBusinessDays(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 2), -100)
+
+		assert.NoError(t, err)
+		assert.Equal(t, timex.Date(2024, 12, 13), actual)
+	})
+
+	t.Run("上限を超える営業日数の足し引きはエラーになる", func(t *testing.T) {
+		_, err := u.AddBusinessDays(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 2), math.MinInt)
+
+		assert.ErrorIs(t, err, model.ErrPeriodTooLong)
+	})
+
+	t.Run("営業日数が数えられる", func(t *testing.T) {
+		actual, err := u.BusinessDaysBetween(context.Background(), model.DefaultCalendarID, timex.Date(2025, 5, 9), timex.Date(2025, 5, 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, -3, actual)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		_, err := u.Roll(context.Background(), "unknown", timex.Date(2025, 5, 3), model.RollFollowing)
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// maxBusinessHoursSearchDays bounds the period searched for enough business hours
+const maxBusinessHoursSearchDays = 366 * 10
+
+// MaxBusinessHoursDuration bounds the business hours added to a time.
+// A year of hours takes a few years of business days, well within the search period.
+const MaxBusinessHoursDuration = 366 * 24 * time.Hour
+
+// BusinessHoursUsecase does arithmetic in the business hours of a calendar
+type BusinessHoursUsecase struct {
+	calendars repository.BusinessCalendarRepository
+	hours     repository.BusinessHoursRepository
+}
+
+// NewBusinessHoursUsecase creates a BusinessHoursUsecase
+func NewBusinessHoursUsecase(calendars repository.BusinessCalendarRepository, hours repository.BusinessHoursRepository) *BusinessHoursUsecase {
+	return &BusinessHoursUsecase{calendars: calendars, hours: hours}
+}
+
+// AddBusinessHours returns the time at which d of business hours of the calendar have passed since t,
+// in the time zone of the calendar
+func (u *BusinessHoursUsecase) AddBusinessHours(ctx context.Context, calendarID string, t time.Time, d time.Duration) (time.Time, error) {
+	if d > MaxBusinessHoursDuration {
+		return time.Time{}, xerrors.Errorf("duration is longer than %s: %w", MaxBusinessHoursDuration, model.ErrPeriodTooLong)
+	}
+
+	// Start with roughly twice the days needed at full days of business hours, and widen the period until it is enough
+	days := min(int(d/(4*time.Hour))+14, maxBusinessHoursSearchDays)
+	for {
+		period := timex.TimeRange{
+			Begin: timex.DateOf(t).AddDate(0, 0, -1),
+			End:   timex.DateOf(t).AddDate(0, 0, days),
+		}
+		clock, err := u.clock(ctx, calendarID, period)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		result, err := clock.AddBusinessHours(t, d)
+		if errors.Is(err, model.ErrOutOfPeriod) && days < maxBusinessHoursSearchDays {
+			days = min(days*2, maxBusinessHoursSearchDays)
+			continue
+		}
+		if err != nil {
+			return time.Time{}, xerrors.Errorf("failed to add business hours: %w", err)
+		}
+		return result, nil
+	}
+}
+
+// BusinessDurationBetween returns the business hours of the calendar between a and b, negative when b is before a
+func (u *BusinessHoursUsecase) BusinessDurationBetween(ctx context.Context, calendarID string, a, b time.Time) (time.Duration, error) {
+	first, last := a, b
+	if last.Before(first) {
+		first, last = last, first
+	}
+	if timex.DateOf(last).Sub(timex.DateOf(first)) > maxBusinessHoursSearchDays*timex.DAY {
+		return 0, xerrors.Errorf("period is longer than %d days: %w", maxBusinessHoursSearchDays, model.ErrPeriodTooLong)
+	}
+
+	// Dates in the time zone of the calendar may differ by a day from the dates in JST
+	period := timex.TimeRange{
+		Begin: timex.DateOf(first).AddDate(0, 0, -1),
+		End:   timex.DateOf(last).AddDate(0, 0, 1),
+	}
+	clock, err := u.clock(ctx, calendarID, period)
+	if err != nil {
+		return 0, err
+	}
+
+	d, err := clock.BusinessDurationBetween(a, b)
+	if err != nil {
+		return 0, xerrors.Errorf("failed to calculate business hours: %w", err)
+	}
+	return d, nil
+}
+
+func (u *BusinessHoursUsecase) clock(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessClock, error) {
+	calendar, err := u.calendars.FindByPeriod(ctx, calendarID, period)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+	hours, err := u.hours.FindByPeriod(ctx, calendarID, period)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load business hours: %w", err)
+	}
+	return model.NewBusinessClock(calendar, hours), nil
+}
@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type fakeBusinessHoursRepository struct {
+	location *time.Location
+}
+
+func (r *fakeBusinessHoursRepository) FindByPeriod(_ context.Context, _ string, _ timex.TimeRange) (*model.BusinessHours, error) {
+	return model.NewBusinessHours(r.location, nil, nil), nil
+}
+
+func TestBusinessHoursUsecase(t *testing.T) {
+	calendars := &fakeBusinessCalendarRepository{
+		closedDays: map[string][]model.ClosedDay{model.DefaultCalendarID: nil},
+	}
+	u := usecase.NewBusinessHoursUsecase(calendars, &fakeBusinessHoursRepository{location: timex.JST})
+
+	t.Run("読み込む期間を広げながら営業時間を加算する", func(t *testing.T) {
+		// 30 business days of 9 hours from Monday 2025-04-07
+		actual, err := u.AddBusinessHours(context.Background(), model.DefaultCalendarID, time.Date(2025, 4, 7, 9, 0, 0, 0, timex.JST), 270*time.Hour)
+
+		assert.NoError(t, err)
+		assert.True(t, time.Date(2025, 5, 16, 18, 0, 0, 0, timex.JST).Equal(actual), actual)
+	})
+
+	t.Run("営業時間の差を求める", func(t *testing.T) {
+		actual, err := u.BusinessDurationBetween(context.Background(), model.DefaultCalendarID,
+			time.Date(2025, 4, 4, 17, 0, 0, 0, timex.JST),
+			time.Date(2025, 4, 7, 10, 0, 0, 0, timex.JST))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2*time.Hour, actual)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		_, err := u.AddBusinessHours(context.Background(), "unknown", time.Date(2025, 4, 7, 9, 0, 0, 0, timex.JST), time.Hour)
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+}
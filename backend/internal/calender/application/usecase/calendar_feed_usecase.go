@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/icalx"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+const (
+	feedProdID    = "-//bright-room//business-calender//JA"
+	feedUIDDomain = "calender.bright-room.dev"
+)
+
+// FeedQuery selects the events of an iCalendar feed
+type FeedQuery struct {
+	CalendarID string
+	Period     timex.TimeRange
+	Kinds      []model.DayKind         // Kinds of days to include, every kind with events when empty
+	Categories []model.HolidayCategory // Holiday categories to include, every category when empty
+	Language   model.Language          // Language of the event summaries, Japanese when empty
+}
+
+// ParseFeedCategories parses a comma separated list of the categories of a feed,
+// which takes both kinds of days and holiday categories like the CATEGORIES of the events
+func ParseFeedCategories(s string) ([]model.DayKind, []model.HolidayCategory, error) {
+	if s == "" {
+		return nil, nil, nil
+	}
+
+	var kinds []model.DayKind
+	var categories []model.HolidayCategory
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if kind, err := model.ParseEntryKind(v); err == nil {
+			kinds = append(kinds, kind)
+			continue
+		}
+		category, err := model.ParseHolidayCategory(v)
+		if err != nil {
+			return nil, nil, xerrors.Errorf("invalid category: %s", v)
+		}
+		categories = append(categories, category)
+	}
+	return kinds, categories, nil
+}
+
+// CalendarFeedUsecase generates iCalendar feeds of national holidays and closed days
+type CalendarFeedUsecase struct {
+	calendars         repository.CalendarRepository
+	businessCalendars repository.BusinessCalendarRepository
+}
+
+// NewCalendarFeedUsecase creates a CalendarFeedUsecase
+func NewCalendarFeedUsecase(calendars repository.CalendarRepository, businessCalendars repository.BusinessCalendarRepository) *CalendarFeedUsecase {
+	return &CalendarFeedUsecase{calendars: calendars, businessCalendars: businessCalendars}
+}
+
+// DefaultFeedPeriod returns the period published when none is requested, from the previous year to the next year
+func DefaultFeedPeriod(now time.Time) timex.TimeRange {
+	return timex.TimeRange{
+		Begin: timex.Date(now.Year()-1, time.January, 1),
+		End:   timex.Date(now.Year()+1, time.December, 31),
+	}
+}
+
+// Feed generates an all-day event for every national holiday, closed day and working-day override of the period
+func (u *CalendarFeedUsecase) Feed(ctx context.Context, q FeedQuery, now time.Time) (*icalx.Calendar, error) {
+	cal, err := u.calendars.FindByID(ctx, q.CalendarID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+
+	calendar, err := u.businessCalendars.FindByPeriod(ctx, q.CalendarID, q.Period)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+
+	feed := &icalx.Calendar{ProdID: feedProdID, Name: cal.Name}
+	for _, e := range holidayEntries(calendar, q.Kinds, q.Categories, q.Language) {
+		feed.Events = append(feed.Events, newFeedEvent(q.CalendarID, e, now))
+	}
+
+	return feed, nil
+}
+
+// newFeedEvent creates an all-day event whose UID only depends on the calendar, date and kind,
+// so that subscribers update rather than duplicate events when the feed is refreshed.
+// The event is categorised by the kind of day followed by its holiday category, if any.
+func newFeedEvent(calendarID string, e HolidayEntry, now time.Time) icalx.Event {
+	categories := []string{string(e.Kind)}
+	if e.Category != "" {
+		categories = append(categories, string(e.Category))
+	}
+	return icalx.Event{
+		UID:        fmt.Sprintf("%s-%s-%s@%s", e.Date.Format("20060102"), strings.ReplaceAll(string(e.Kind), "_", "-"), calendarID, feedUIDDomain),
+		DTStamp:    now,
+		Start:      e.Date,
+		End:        e.Date.AddDate(0, 0, 1),
+		AllDay:     true,
+		Summary:    e.Summary,
+		Categories: categories,
+	}
+}
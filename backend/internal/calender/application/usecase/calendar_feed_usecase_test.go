@@ -0,0 +1,137 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type fakeCalendarRepository struct {
+	calendars []model.Calendar
+}
+
+func (r *fakeCalendarRepository) FindAll(_ context.Context) ([]model.Calendar, error) {
+	return r.calendars, nil
+}
+
+func (r *fakeCalendarRepository) FindByID(_ context.Context, id string) (*model.Calendar, error) {
+	for _, c := range r.calendars {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, model.ErrCalendarNotFound
+}
+
+type fakeBusinessCalendarRepository struct {
+	nationalHolidays []model.NationalHoliday
+	closedDays       map[string][]model.ClosedDay // Closed days by calendar id
+	overrides        []model.WorkingDayOverride
+}
+
+func (r *fakeBusinessCalendarRepository) FindByPeriod(_ context.Context, calendarID string, period timex.TimeRange) (*model.BusinessCalendar, error) {
+	closedDays, ok := r.closedDays[calendarID]
+	if !ok {
+		return nil, model.ErrCalendarNotFound
+	}
+	return model.NewBusinessCalendar(period, r.nationalHolidays, closedDays, r.overrides, nil), nil
+}
+
+func TestCalendarFeedUsecase_Feed(t *testing.T) {
+	repo := &fakeBusinessCalendarRepository{
+		nationalHolidays: []model.NationalHoliday{
+			{Date: timex.Date(2025, 1, 1), Summary: "元日", Category: model.HolidayCategoryStatutory},
+			{Date: timex.Date(2025, 5, 6), Summary: "休日", Category: model.HolidayCategorySubstitute},
+		},
+		closedDays: map[string][]model.ClosedDay{
+			model.DefaultCalendarID: {{Date: timex.Date(2025, 1, 2), Summary: "年末年始休業", Category: model.HolidayCategoryCompany}},
+			"factory":               {{Date: timex.Date(2025, 8, 13), Summary: "夏季休業", Category: model.HolidayCategoryCompany}},
+		},
+		overrides: []model.WorkingDayOverride{{Date: timex.Date(2025, 4, 29), Summary: "棚卸"}},
+	}
+	calendars := &fakeCalendarRepository{calendars: []model.Calendar{
+		{ID: model.DefaultCalendarID, Name: "本社"},
+		{ID: "factory", Name: "工場"},
+	}}
+	u := usecase.NewCalendarFeedUsecase(calendars, repo)
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 12, 31)}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("祝日と休業日が終日イベントになる", func(t *testing.T) {
+		actual, err := u.Feed(context.Background(), usecase.FeedQuery{CalendarID: model.DefaultCalendarID, Period: period}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "本社", actual.Name)
+		assert.Equal(t, 4, len(actual.Events))
+		assert.Equal(t, "20250101-national-holiday-default@calender.bright-room.dev", actual.Events[0].UID)
+		assert.Equal(t, timex.Date(2025, 1, 2), actual.Events[0].End)
+		assert.Equal(t, []string{"national_holiday", "statutory"}, actual.Events[0].Categories)
+		assert.Equal(t, "20250102-closed-day-default@calender.bright-room.dev", actual.Events[2].UID)
+		assert.Equal(t, "20250429-working-day-override-default@calender.bright-room.dev", actual.Events[3].UID)
+		assert.Equal(t, []string{"working_day_override"}, actual.Events[3].Categories)
+	})
+
+	t.Run("祝日の種類で絞り込める", func(t *testing.T) {
+		actual, err := u.Feed(context.Background(), usecase.FeedQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     period,
+			Categories: []model.HolidayCategory{model.HolidayCategorySubstitute},
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(actual.Events))
+		assert.Equal(t, "20250506-national-holiday-default@calender.bright-room.dev", actual.Events[0].UID)
+	})
+
+	t.Run("カテゴリで絞り込める", func(t *testing.T) {
+		actual, err := u.Feed(context.Background(), usecase.FeedQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     period,
+			Kinds:      []model.DayKind{model.DayKindClosedDay},
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(actual.Events))
+		assert.Equal(t, "年末年始休業", actual.Events[0].Summary)
+	})
+
+	t.Run("カレンダーごとの休業日が出力される", func(t *testing.T) {
+		actual, err := u.Feed(context.Background(), usecase.FeedQuery{
+			CalendarID: "factory",
+			Period:     period,
+			Kinds:      []model.DayKind{model.DayKindClosedDay},
+		}, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "工場", actual.Name)
+		assert.Equal(t, 1, len(actual.Events))
+		assert.Equal(t, "20250813-closed-day-factory@calender.bright-room.dev", actual.Events[0].UID)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		_, err := u.Feed(context.Background(), usecase.FeedQuery{CalendarID: "unknown", Period: period}, now)
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+}
+
+func TestParseFeedCategories(t *testing.T) {
+	t.Run("日の種類と祝日の種類に分けられる", func(t *testing.T) {
+		kinds, categories, err := usecase.ParseFeedCategories("national_holiday, substitute,working_day_override")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.DayKind{model.DayKindNationalHoliday, model.DayKindWorkingOverride}, kinds)
+		assert.Equal(t, []model.HolidayCategory{model.HolidayCategorySubstitute}, categories)
+	})
+
+	t.Run("未知のカテゴリはエラーになる", func(t *testing.T) {
+		_, _, err := usecase.ParseFeedCategories("national_holidy")
+
+		assert.Error(t, err)
+	})
+}
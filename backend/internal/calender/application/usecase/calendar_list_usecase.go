@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+)
+
+// CalendarListUsecase lists the calendars that business-day queries can be made against
+type CalendarListUsecase struct {
+	calendars repository.CalendarRepository
+}
+
+// NewCalendarListUsecase creates a CalendarListUsecase
+func NewCalendarListUsecase(calendars repository.CalendarRepository) *CalendarListUsecase {
+	return &CalendarListUsecase{calendars: calendars}
+}
+
+// List returns every calendar in identifier order
+func (u *CalendarListUsecase) List(ctx context.Context) ([]model.Calendar, error) {
+	calendars, err := u.calendars.FindAll(ctx)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to list calendars: %w", err)
+	}
+	return calendars, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// CalendarViewUsecase builds month grids for rendering calendars
+type CalendarViewUsecase struct {
+	calendars repository.BusinessCalendarRepository
+}
+
+// NewCalendarViewUsecase creates a CalendarViewUsecase
+func NewCalendarViewUsecase(calendars repository.BusinessCalendarRepository) *CalendarViewUsecase {
+	return &CalendarViewUsecase{calendars: calendars}
+}
+
+// Month returns the grid of a single month
+func (u *CalendarViewUsecase) Month(ctx context.Context, calendarID string, year int, month time.Month, weekStart time.Weekday) (model.MonthView, error) {
+	calendar, err := u.calendars.FindByPeriod(ctx, calendarID, model.MonthGridPeriod(year, month, weekStart))
+	if err != nil {
+		return model.MonthView{}, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+
+	return model.NewMonthView(calendar, year, month, weekStart), nil
+}
+
+// Year returns the grids of every month of the year, loaded with a single range query
+func (u *CalendarViewUsecase) Year(ctx context.Context, calendarID string, year int, weekStart time.Weekday) ([]model.MonthView, error) {
+	period := timex.TimeRange{
+		Begin: model.MonthGridPeriod(year, time.January, weekStart).Begin,
+		End:   model.MonthGridPeriod(year, time.December, weekStart).End,
+	}
+
+	calendar, err := u.calendars.FindByPeriod(ctx, calendarID, period)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+
+	views := make([]model.MonthView, 0, 12)
+	for m := time.January; m <= time.December; m++ {
+		views = append(views, model.NewMonthView(calendar, year, m, weekStart))
+	}
+	return views, nil
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/icalx"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// maxClosedDaySummaryLength is the length of closed_days.summary
+const maxClosedDaySummaryLength = 50
+
+// ImportQuery describes an iCalendar import
+type ImportQuery struct {
+	CalendarID string
+	Horizon    time.Time // Recurring events are expanded up to and including this date
+	DryRun     bool      // Whether to only return the imported days without saving them
+}
+
+// ClosedDayImportUsecase imports closed days and working-day overrides from iCalendar files
+type ClosedDayImportUsecase struct {
+	closedDays repository.ClosedDayRepository
+	overrides  repository.WorkingDayOverrideRepository
+}
+
+// NewClosedDayImportUsecase creates a ClosedDayImportUsecase
+func NewClosedDayImportUsecase(closedDays repository.ClosedDayRepository, overrides repository.WorkingDayOverrideRepository) *ClosedDayImportUsecase {
+	return &ClosedDayImportUsecase{closedDays: closedDays, overrides: overrides}
+}
+
+// Import expands every event of the iCalendar file to the dates it covers and saves them as closed days of the calendar
+// with the summary of the event. When several events cover the same date the first one wins.
+// The closed days are returned in date order.
+func (u *ClosedDayImportUsecase) Import(ctx context.Context, r io.Reader, q ImportQuery) ([]model.ClosedDay, error) {
+	cal, err := icalx.Parse(r)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse iCalendar: %w", err)
+	}
+
+	closedDays, err := expandClosedDays(cal.Events, q.Horizon)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.DryRun {
+		return closedDays, nil
+	}
+	// Consecutive days of an event are stored as a single closure
+	if err := u.closedDays.Save(ctx, q.CalendarID, model.NewClosures(closedDays)); err != nil {
+		return nil, xerrors.Errorf("failed to import closed days: %w", err)
+	}
+	return closedDays, nil
+}
+
+// ImportOverrides expands every event of the iCalendar file in the same way as Import and saves the dates as
+// working-day overrides of the calendar, such as stocktaking days the company works on national holidays.
+// The overrides are returned in date order.
+func (u *ClosedDayImportUsecase) ImportOverrides(ctx context.Context, r io.Reader, q ImportQuery) ([]model.WorkingDayOverride, error) {
+	cal, err := icalx.Parse(r)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse iCalendar: %w", err)
+	}
+
+	days, err := expandClosedDays(cal.Events, q.Horizon)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make([]model.WorkingDayOverride, 0, len(days))
+	for _, d := range days {
+		overrides = append(overrides, model.WorkingDayOverride{Date: d.Date, Summary: d.Summary})
+	}
+
+	if q.DryRun {
+		return overrides, nil
+	}
+	if err := u.overrides.Save(ctx, q.CalendarID, overrides); err != nil {
+		return nil, xerrors.Errorf("failed to import working-day overrides: %w", err)
+	}
+	return overrides, nil
+}
+
+// expandClosedDays converts the occurrences of the events to closed days in JST
+func expandClosedDays(events []icalx.Event, horizon time.Time) ([]model.ClosedDay, error) {
+	seen := map[string]bool{}
+	var closedDays []model.ClosedDay
+	for _, e := range events {
+		if e.Summary == "" {
+			return nil, xerrors.Errorf("event %s has no summary", e.UID)
+		}
+		if utf8.RuneCountInString(e.Summary) > maxClosedDaySummaryLength {
+			return nil, xerrors.Errorf("summary of event %s is longer than %d characters: %s", e.UID, maxClosedDaySummaryLength, e.Summary)
+		}
+
+		category := eventCategory(e)
+		occurrences, err := e.Occurrences(horizon)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to expand event %s: %w", e.UID, err)
+		}
+
+		for _, start := range occurrences {
+			for _, date := range eventDates(e, start) {
+				key := date.Format(time.DateOnly)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				closedDays = append(closedDays, model.ClosedDay{Date: date, Summary: e.Summary, Category: category})
+			}
+		}
+	}
+
+	sort.Slice(closedDays, func(i, j int) bool { return closedDays[i].Date.Before(closedDays[j].Date) })
+	return closedDays, nil
+}
+
+// eventCategory returns the first holiday category among the categories of the event, such as those of our own feeds,
+// and company closures for events without one
+func eventCategory(e icalx.Event) model.HolidayCategory {
+	for _, c := range e.Categories {
+		if category, err := model.ParseHolidayCategory(c); err == nil {
+			return category
+		}
+	}
+	return model.HolidayCategoryCompany
+}
+
+// eventDates returns the dates covered by the occurrence of the event starting at start.
+// All-day events cover the dates before their exclusive end, timed events every date they touch in JST.
+func eventDates(e icalx.Event, start time.Time) []time.Time {
+	var first, last time.Time
+	if e.AllDay {
+		first = timex.DateOf(start)
+		days := 1
+		if !e.End.IsZero() && e.End.After(e.Start) {
+			days = int(timex.DateOf(e.End).Sub(timex.DateOf(e.Start)) / (24 * time.Hour))
+		}
+		last = first.AddDate(0, 0, days-1)
+	} else {
+		first = timex.DateOf(start.In(timex.JST))
+		last = first
+		if d := e.Duration(); d > 0 {
+			// The end is exclusive, so an event ending at midnight does not cover the next date
+			last = timex.DateOf(start.Add(d - time.Nanosecond).In(timex.JST))
+		}
+	}
+
+	var dates []time.Time
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		dates = append(dates, d)
+	}
+	return dates
+}
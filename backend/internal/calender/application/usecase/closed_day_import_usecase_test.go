@@ -0,0 +1,164 @@
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type fakeClosedDayRepository struct {
+	saved map[string][]model.Closure // Saved closures by calendar id, only calendars with a key exist
+}
+
+func newFakeClosedDayRepository(calendarIDs ...string) *fakeClosedDayRepository {
+	r := &fakeClosedDayRepository{saved: map[string][]model.Closure{}}
+	for _, id := range calendarIDs {
+		r.saved[id] = nil
+	}
+	return r
+}
+
+func (r *fakeClosedDayRepository) Save(_ context.Context, calendarID string, closures []model.Closure) error {
+	if _, ok := r.saved[calendarID]; !ok {
+		return model.ErrCalendarNotFound
+	}
+	r.saved[calendarID] = append(r.saved[calendarID], closures...)
+	return nil
+}
+
+type fakeWorkingDayOverrideRepository struct {
+	saved map[string][]model.WorkingDayOverride // Saved overrides by calendar id
+}
+
+func (r *fakeWorkingDayOverrideRepository) Save(_ context.Context, calendarID string, overrides []model.WorkingDayOverride) error {
+	r.saved[calendarID] = append(r.saved[calendarID], overrides...)
+	return nil
+}
+
+func TestClosedDayImportUsecase_Import(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:new-year@example.com",
+		"DTSTART;VALUE=DATE:20251230",
+		"DTEND;VALUE=DATE:20260104",
+		"SUMMARY:年末年始休業",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:summer@example.com",
+		"DTSTART;VALUE=DATE:20250813",
+		"DURATION:P2D",
+		"RRULE:FREQ=YEARLY;COUNT=2",
+		"EXDATE;VALUE=DATE:20260813",
+		"SUMMARY:夏季休業",
+		"CATEGORIES:closed_day,special",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:inventory@example.com",
+		"DTSTART:20251231T150000Z",
+		"DTEND:20260101T010000Z",
+		"SUMMARY:棚卸",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+	horizon := timex.Date(2026, 12, 31)
+
+	t.Run("イベントが日付に展開されて期間ごとに保存される", func(t *testing.T) {
+		repo := newFakeClosedDayRepository(model.DefaultCalendarID)
+		u := usecase.NewClosedDayImportUsecase(repo, nil)
+
+		actual, err := u.Import(context.Background(), strings.NewReader(input), usecase.ImportQuery{
+			CalendarID: model.DefaultCalendarID,
+			Horizon:    horizon,
+		})
+
+		assert.NoError(t, err)
+		// Events without a holiday category are company closures
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 8, 13), Summary: "夏季休業", Category: model.HolidayCategorySpecial},
+			{Date: timex.Date(2025, 8, 14), Summary: "夏季休業", Category: model.HolidayCategorySpecial},
+			{Date: timex.Date(2025, 12, 30), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+			{Date: timex.Date(2025, 12, 31), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+			{Date: timex.Date(2026, 1, 1), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+			{Date: timex.Date(2026, 1, 2), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+			{Date: timex.Date(2026, 1, 3), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+		}, actual)
+		// Consecutive days of an event are saved as a single closure
+		assert.Equal(t, []model.Closure{
+			{StartsOn: timex.Date(2025, 8, 13), EndsOn: timex.Date(2025, 8, 14), Summary: "夏季休業", Category: model.HolidayCategorySpecial},
+			{StartsOn: timex.Date(2025, 12, 30), EndsOn: timex.Date(2026, 1, 3), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+		}, repo.saved[model.DefaultCalendarID])
+	})
+
+	t.Run("ドライランでは保存されない", func(t *testing.T) {
+		repo := newFakeClosedDayRepository(model.DefaultCalendarID)
+		u := usecase.NewClosedDayImportUsecase(repo, nil)
+
+		actual, err := u.Import(context.Background(), strings.NewReader(input), usecase.ImportQuery{
+			CalendarID: model.DefaultCalendarID,
+			Horizon:    horizon,
+			DryRun:     true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, len(actual))
+		assert.Empty(t, repo.saved[model.DefaultCalendarID])
+	})
+
+	t.Run("長すぎる件名はエラーになる", func(t *testing.T) {
+		u := usecase.NewClosedDayImportUsecase(newFakeClosedDayRepository(model.DefaultCalendarID), nil)
+		long := "BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20250101\r\nSUMMARY:" + strings.Repeat("休", 51) + "\r\nEND:VEVENT\r\n"
+
+		_, err := u.Import(context.Background(), strings.NewReader(long), usecase.ImportQuery{
+			CalendarID: model.DefaultCalendarID,
+			Horizon:    horizon,
+		})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		u := usecase.NewClosedDayImportUsecase(newFakeClosedDayRepository(model.DefaultCalendarID), nil)
+
+		_, err := u.Import(context.Background(), strings.NewReader(input), usecase.ImportQuery{CalendarID: "unknown", Horizon: horizon})
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+}
+
+func TestClosedDayImportUsecase_ImportOverrides(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:inventory@example.com",
+		"DTSTART;VALUE=DATE:20250429",
+		"DURATION:P1D",
+		"RRULE:FREQ=YEARLY;COUNT=2",
+		"SUMMARY:棚卸",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	t.Run("イベントの日付が休日出勤として保存される", func(t *testing.T) {
+		repo := &fakeWorkingDayOverrideRepository{saved: map[string][]model.WorkingDayOverride{}}
+		u := usecase.NewClosedDayImportUsecase(nil, repo)
+
+		actual, err := u.ImportOverrides(context.Background(), strings.NewReader(input), usecase.ImportQuery{
+			CalendarID: model.DefaultCalendarID,
+			Horizon:    timex.Date(2026, 12, 31),
+		})
+
+		expected := []model.WorkingDayOverride{
+			{Date: timex.Date(2025, 4, 29), Summary: "棚卸"},
+			{Date: timex.Date(2026, 4, 29), Summary: "棚卸"},
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, expected, repo.saved[model.DefaultCalendarID])
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// HolidayQuery selects the national holidays, closed days and working-day overrides of a calendar
+type HolidayQuery struct {
+	CalendarID string
+	Period     timex.TimeRange
+	Kinds      []model.DayKind         // Kinds of days to include, every kind with entries when empty
+	Categories []model.HolidayCategory // Holiday categories to include, every category when empty. Working-day overrides have no category and are left out when set.
+	Language   model.Language          // Language of the summaries, Japanese when empty
+}
+
+// HolidayEntry is a national holiday, closed day or working-day override of a calendar
+type HolidayEntry struct {
+	Date     time.Time
+	Kind     model.DayKind
+	Category model.HolidayCategory // Empty for working-day overrides
+	Summary  string                // Name in the requested language
+}
+
+// HolidayListUsecase lists the holidays of a calendar
+type HolidayListUsecase struct {
+	businessCalendars repository.BusinessCalendarRepository
+}
+
+// NewHolidayListUsecase creates a HolidayListUsecase
+func NewHolidayListUsecase(businessCalendars repository.BusinessCalendarRepository) *HolidayListUsecase {
+	return &HolidayListUsecase{businessCalendars: businessCalendars}
+}
+
+// List returns the national holidays, closed days and working-day overrides of the period in date order.
+// Entries on the same date are ordered national holiday, closed day and working-day override.
+func (u *HolidayListUsecase) List(ctx context.Context, q HolidayQuery) ([]HolidayEntry, error) {
+	calendar, err := u.businessCalendars.FindByPeriod(ctx, q.CalendarID, q.Period)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load calendar: %w", err)
+	}
+
+	entries := holidayEntries(calendar, q.Kinds, q.Categories, q.Language)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Date.Before(entries[j].Date) })
+	return entries, nil
+}
+
+// holidayEntries returns the entries of the calendar matching the kinds and categories with summaries in the language,
+// grouped by kind in the order national holiday, closed day and working-day override
+func holidayEntries(calendar *model.BusinessCalendar, kinds []model.DayKind, categories []model.HolidayCategory, lang model.Language) []HolidayEntry {
+	include := func(kind model.DayKind, category model.HolidayCategory) bool {
+		return (len(kinds) == 0 || slices.Contains(kinds, kind)) && (len(categories) == 0 || slices.Contains(categories, category))
+	}
+
+	var entries []HolidayEntry
+	for _, h := range calendar.NationalHolidays() {
+		if include(model.DayKindNationalHoliday, h.Category) {
+			entries = append(entries, HolidayEntry{Date: h.Date, Kind: model.DayKindNationalHoliday, Category: h.Category, Summary: h.Name(lang)})
+		}
+	}
+	for _, d := range calendar.ClosedDays() {
+		if include(model.DayKindClosedDay, d.Category) {
+			entries = append(entries, HolidayEntry{Date: d.Date, Kind: model.DayKindClosedDay, Category: d.Category, Summary: d.Name(lang)})
+		}
+	}
+	for _, o := range calendar.WorkingDayOverrides() {
+		if include(model.DayKindWorkingOverride, "") {
+			entries = append(entries, HolidayEntry{Date: o.Date, Kind: model.DayKindWorkingOverride, Summary: o.Summary})
+		}
+	}
+	return entries
+}
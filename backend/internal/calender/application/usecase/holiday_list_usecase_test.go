@@ -0,0 +1,87 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestHolidayListUsecase_List(t *testing.T) {
+	repo := &fakeBusinessCalendarRepository{
+		nationalHolidays: []model.NationalHoliday{
+			{Date: timex.Date(2025, 5, 5), Summary: "こどもの日", Category: model.HolidayCategoryStatutory},
+			{Date: timex.Date(2025, 5, 6), Summary: "休日", Category: model.HolidayCategorySubstitute},
+		},
+		closedDays: map[string][]model.ClosedDay{
+			model.DefaultCalendarID: {
+				{Date: timex.Date(2025, 5, 2), Summary: "休業日", Category: model.HolidayCategoryCompany},
+				{Date: timex.Date(2025, 5, 6), Summary: "休業日", Category: model.HolidayCategoryCompany},
+			},
+		},
+		overrides: []model.WorkingDayOverride{{Date: timex.Date(2025, 5, 5), Summary: "イベント出勤"}},
+	}
+	u := usecase.NewHolidayListUsecase(repo)
+	period := timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 5, 31)}
+
+	t.Run("日付順に並び、同じ日付は祝日、休業日、出勤日の順になる", func(t *testing.T) {
+		actual, err := u.List(context.Background(), usecase.HolidayQuery{CalendarID: model.DefaultCalendarID, Period: period})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []usecase.HolidayEntry{
+			{Date: timex.Date(2025, 5, 2), Kind: model.DayKindClosedDay, Category: model.HolidayCategoryCompany, Summary: "休業日"},
+			{Date: timex.Date(2025, 5, 5), Kind: model.DayKindNationalHoliday, Category: model.HolidayCategoryStatutory, Summary: "こどもの日"},
+			{Date: timex.Date(2025, 5, 5), Kind: model.DayKindWorkingOverride, Summary: "イベント出勤"},
+			{Date: timex.Date(2025, 5, 6), Kind: model.DayKindNationalHoliday, Category: model.HolidayCategorySubstitute, Summary: "休日"},
+			{Date: timex.Date(2025, 5, 6), Kind: model.DayKindClosedDay, Category: model.HolidayCategoryCompany, Summary: "休業日"},
+		}, actual)
+	})
+
+	t.Run("祝日の種類で絞り込むと出勤日は含まれない", func(t *testing.T) {
+		actual, err := u.List(context.Background(), usecase.HolidayQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     period,
+			Categories: []model.HolidayCategory{model.HolidayCategorySubstitute, model.HolidayCategoryStatutory},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(actual))
+		assert.Equal(t, "こどもの日", actual[0].Summary)
+		assert.Equal(t, "休日", actual[1].Summary)
+	})
+
+	t.Run("種類と祝日の種類の両方に一致するものだけが含まれる", func(t *testing.T) {
+		actual, err := u.List(context.Background(), usecase.HolidayQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     period,
+			Kinds:      []model.DayKind{model.DayKindClosedDay},
+			Categories: []model.HolidayCategory{model.HolidayCategorySubstitute},
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("指定した言語の名前になる", func(t *testing.T) {
+		actual, err := u.List(context.Background(), usecase.HolidayQuery{
+			CalendarID: model.DefaultCalendarID,
+			Period:     period,
+			Kinds:      []model.DayKind{model.DayKindNationalHoliday},
+			Language:   model.LanguageEnglish,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(actual))
+		assert.Equal(t, "Children's Day", actual[0].Summary)
+		assert.Equal(t, "Substitute Holiday", actual[1].Summary)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		_, err := u.List(context.Background(), usecase.HolidayQuery{CalendarID: "unknown", Period: period})
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+}
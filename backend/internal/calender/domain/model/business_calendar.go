@@ -0,0 +1,196 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// DefaultCalendarID is the identifier of the calendar used when none is specified
+const DefaultCalendarID = "default"
+
+// ErrCalendarNotFound is returned when no calendar has the requested identifier
+var ErrCalendarNotFound = errors.New("calendar not found")
+
+// DayKind classifies a day by the reason it is, or is not, a business day
+type DayKind string
+
+const (
+	DayKindBusinessDay     DayKind = "business_day"
+	DayKindWeekend         DayKind = "weekend"
+	DayKindNationalHoliday DayKind = "national_holiday"
+	DayKindClosedDay       DayKind = "closed_day"
+	DayKindShortDay        DayKind = "short_day"
+	DayKindWorkingOverride DayKind = "working_day_override"
+)
+
+// ParseEntryKind parses a kind of day that has entries in holiday listings and feeds, such as "national_holiday"
+func ParseEntryKind(s string) (DayKind, error) {
+	switch kind := DayKind(s); kind {
+	case DayKindNationalHoliday, DayKindClosedDay, DayKindWorkingOverride:
+		return kind, nil
+	default:
+		return "", xerrors.Errorf("invalid kind: %s", s)
+	}
+}
+
+// ShortDayFraction is the share of a business day a short day counts as
+const ShortDayFraction = 0.5
+
+// DayStatus is the business-day status of a single date
+type DayStatus struct {
+	Date          time.Time
+	Kind          DayKind
+	IsBusinessDay bool
+	Summary       string
+	Category      HolidayCategory // Category of the national holiday or closed day, empty for other kinds
+	Names         LocalizedNames  // Names of the national holiday or closed day by language
+	OpensAt       time.Duration   // Time business starts on a short day, zero when it starts as usual
+	ClosesAt      time.Duration   // Time business ends on a short day, zero when it ends as usual
+}
+
+// Fraction returns the share of a business day the date counts as: 1 for a business day,
+// ShortDayFraction for a short day and 0 otherwise
+func (s DayStatus) Fraction() float64 {
+	switch {
+	case !s.IsBusinessDay:
+		return 0
+	case s.Kind == DayKindShortDay:
+		return ShortDayFraction
+	default:
+		return 1
+	}
+}
+
+// Name returns the summary of the day in the language
+func (s DayStatus) Name(lang Language) string {
+	return Localize(lang, s.Summary, s.Category, s.Names)
+}
+
+// Weekday returns the day of the week of the date
+func (s DayStatus) Weekday() time.Weekday {
+	return s.Date.Weekday()
+}
+
+// BusinessCalendar holds the holidays, closed days and weekly patterns of a period and answers per-date questions about it
+type BusinessCalendar struct {
+	period           timex.TimeRange
+	nationalHolidays map[string]NationalHoliday
+	closedDays       map[string]ClosedDay
+	overrides        map[string]WorkingDayOverride
+	weeklyPatterns   weeklyPatterns
+}
+
+// NewBusinessCalendar creates a BusinessCalendar for the given period.
+// Days are worked Monday to Friday when there are no weekly patterns.
+func NewBusinessCalendar(period timex.TimeRange, nationalHolidays []NationalHoliday, closedDays []ClosedDay, overrides []WorkingDayOverride, weeklyPatterns []WeeklyPattern) *BusinessCalendar {
+	c := &BusinessCalendar{
+		period:           period,
+		nationalHolidays: make(map[string]NationalHoliday, len(nationalHolidays)),
+		closedDays:       make(map[string]ClosedDay, len(closedDays)),
+		overrides:        make(map[string]WorkingDayOverride, len(overrides)),
+		weeklyPatterns:   newWeeklyPatterns(weeklyPatterns),
+	}
+	for _, h := range nationalHolidays {
+		c.nationalHolidays[dateKey(h.Date)] = h
+	}
+	for _, d := range closedDays {
+		c.closedDays[dateKey(d.Date)] = d
+	}
+	for _, o := range overrides {
+		c.overrides[dateKey(o.Date)] = o
+	}
+	return c
+}
+
+// Period returns the period the calendar was loaded for
+func (c *BusinessCalendar) Period() timex.TimeRange {
+	return c.period
+}
+
+// Contains reports whether the date is inside the loaded period
+func (c *BusinessCalendar) Contains(date time.Time) bool {
+	date = timex.DateOf(date)
+	return !date.Before(timex.DateOf(c.period.Begin)) && !date.After(timex.DateOf(c.period.End))
+}
+
+// Status returns the business-day status of the date.
+// A working-day override takes precedence over everything else, making the date a business day.
+// Otherwise a national holiday takes precedence over a closed day on the same date, and both over a weekend,
+// which is any day that is not a working day of the weekly pattern in effect.
+// A partial closure on a working day makes it a short day, which is still a business day.
+func (c *BusinessCalendar) Status(date time.Time) DayStatus {
+	date = timex.DateOf(date)
+	key := dateKey(date)
+
+	if o, ok := c.overrides[key]; ok {
+		return DayStatus{Date: date, Kind: DayKindWorkingOverride, IsBusinessDay: true, Summary: o.Summary}
+	}
+	if h, ok := c.nationalHolidays[key]; ok {
+		return DayStatus{Date: date, Kind: DayKindNationalHoliday, Summary: h.Summary, Category: h.Category, Names: h.Names}
+	}
+	working := c.weeklyPatterns.workingDays(date).Contains(date.Weekday())
+	if d, ok := c.closedDays[key]; ok {
+		if !d.IsPartial() {
+			return DayStatus{Date: date, Kind: DayKindClosedDay, Summary: d.Summary, Category: d.Category, Names: d.Names}
+		}
+		if working {
+			return DayStatus{Date: date, Kind: DayKindShortDay, IsBusinessDay: true, Summary: d.Summary, Category: d.Category, Names: d.Names, OpensAt: d.OpensAt, ClosesAt: d.ClosesAt}
+		}
+	}
+	if !working {
+		return DayStatus{Date: date, Kind: DayKindWeekend}
+	}
+	return DayStatus{Date: date, Kind: DayKindBusinessDay, IsBusinessDay: true}
+}
+
+// NationalHolidays returns the national holidays of the period in date order
+func (c *BusinessCalendar) NationalHolidays() []NationalHoliday {
+	holidays := make([]NationalHoliday, 0, len(c.nationalHolidays))
+	for _, h := range c.nationalHolidays {
+		holidays = append(holidays, h)
+	}
+	sort.Slice(holidays, func(i, j int) bool { return holidays[i].Date.Before(holidays[j].Date) })
+	return holidays
+}
+
+// ClosedDays returns the closed days of the period in date order
+func (c *BusinessCalendar) ClosedDays() []ClosedDay {
+	closedDays := make([]ClosedDay, 0, len(c.closedDays))
+	for _, d := range c.closedDays {
+		closedDays = append(closedDays, d)
+	}
+	sort.Slice(closedDays, func(i, j int) bool { return closedDays[i].Date.Before(closedDays[j].Date) })
+	return closedDays
+}
+
+// WorkingDayOverrides returns the working-day overrides of the period in date order
+func (c *BusinessCalendar) WorkingDayOverrides() []WorkingDayOverride {
+	overrides := make([]WorkingDayOverride, 0, len(c.overrides))
+	for _, o := range c.overrides {
+		overrides = append(overrides, o)
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].Date.Before(overrides[j].Date) })
+	return overrides
+}
+
+// IsBusinessDay reports whether the date is a business day, including short days
+func (c *BusinessCalendar) IsBusinessDay(date time.Time) bool {
+	return c.Status(date).IsBusinessDay
+}
+
+// BusinessDays returns the number of business days from begin to end inclusive, counting short days by their fraction
+func (c *BusinessCalendar) BusinessDays(begin, end time.Time) float64 {
+	var total float64
+	for d := timex.DateOf(begin); !d.After(timex.DateOf(end)); d = d.AddDate(0, 0, 1) {
+		total += c.Status(d).Fraction()
+	}
+	return total
+}
+
+func dateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
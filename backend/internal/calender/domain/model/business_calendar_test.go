@@ -0,0 +1,194 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestBusinessCalendar_Status(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 1, 31)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{
+			{Date: timex.Date(2025, 1, 1), Summary: "元日", Category: model.HolidayCategoryStatutory},
+			{Date: timex.Date(2025, 1, 13), Summary: "成人の日", Category: model.HolidayCategoryStatutory},
+		},
+		[]model.ClosedDay{
+			{Date: timex.Date(2025, 1, 1), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+			{Date: timex.Date(2025, 1, 2), Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+		},
+		nil,
+		nil,
+	)
+
+	tests := []struct {
+		name     string
+		date     int
+		expected model.DayStatus
+	}{
+		{
+			name:     "祝日と休業日が重なる場合は祝日になる",
+			date:     1,
+			expected: model.DayStatus{Date: timex.Date(2025, 1, 1), Kind: model.DayKindNationalHoliday, Summary: "元日", Category: model.HolidayCategoryStatutory},
+		},
+		{
+			name:     "休業日",
+			date:     2,
+			expected: model.DayStatus{Date: timex.Date(2025, 1, 2), Kind: model.DayKindClosedDay, Summary: "年末年始休業", Category: model.HolidayCategoryCompany},
+		},
+		{
+			name:     "週末",
+			date:     4,
+			expected: model.DayStatus{Date: timex.Date(2025, 1, 4), Kind: model.DayKindWeekend},
+		},
+		{
+			name:     "営業日",
+			date:     6,
+			expected: model.DayStatus{Date: timex.Date(2025, 1, 6), Kind: model.DayKindBusinessDay, IsBusinessDay: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := calendar.Status(timex.Date(2025, 1, tt.date))
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestBusinessCalendar_WeeklyPatterns(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 3, 1), End: timex.Date(2025, 4, 30)}
+	calendar := model.NewBusinessCalendar(period, nil, nil, nil, []model.WeeklyPattern{
+		{
+			EffectiveFrom: timex.Date(2025, 4, 1),
+			WorkingDays:   model.NewWeekdays(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday),
+		},
+		{
+			EffectiveFrom: timex.Date(2025, 3, 15),
+			WorkingDays:   model.NewWeekdays(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday),
+		},
+	})
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected model.DayKind
+	}{
+		{name: "最初のパターンより前は土曜日が休日になる", date: timex.Date(2025, 3, 8), expected: model.DayKindWeekend},
+		{name: "月曜から土曜のパターンでは土曜日が営業日になる", date: timex.Date(2025, 3, 15), expected: model.DayKindBusinessDay},
+		{name: "月曜から土曜のパターンでは日曜日が休日になる", date: timex.Date(2025, 3, 16), expected: model.DayKindWeekend},
+		{name: "日曜から木曜のパターンでは日曜日が営業日になる", date: timex.Date(2025, 4, 6), expected: model.DayKindBusinessDay},
+		{name: "日曜から木曜のパターンでは金曜日が休日になる", date: timex.Date(2025, 4, 4), expected: model.DayKindWeekend},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, calendar.Status(tt.date).Kind)
+		})
+	}
+}
+
+func TestBusinessCalendar_ShortDays(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 12, 22), End: timex.Date(2025, 12, 31)}
+	calendar := model.NewBusinessCalendar(period, nil, []model.ClosedDay{
+		{Date: timex.Date(2025, 12, 26), Summary: "仕事納め", ClosesAt: 12 * time.Hour},
+		{Date: timex.Date(2025, 12, 27), Summary: "土曜の半休", ClosesAt: 12 * time.Hour},
+		{Date: timex.Date(2025, 12, 29), Summary: "年末年始休業"},
+	}, nil, nil)
+
+	t.Run("部分休業の平日は短縮営業日になる", func(t *testing.T) {
+		actual := calendar.Status(timex.Date(2025, 12, 26))
+
+		assert.Equal(t, model.DayStatus{
+			Date:          timex.Date(2025, 12, 26),
+			Kind:          model.DayKindShortDay,
+			IsBusinessDay: true,
+			Summary:       "仕事納め",
+			ClosesAt:      12 * time.Hour,
+		}, actual)
+		assert.Equal(t, model.ShortDayFraction, actual.Fraction())
+	})
+
+	t.Run("休日の部分休業は休日のまま", func(t *testing.T) {
+		assert.Equal(t, model.DayKindWeekend, calendar.Status(timex.Date(2025, 12, 27)).Kind)
+	})
+
+	t.Run("短縮営業日は0.5日として数えられる", func(t *testing.T) {
+		assert.Equal(t, 4.5, calendar.BusinessDays(timex.Date(2025, 12, 22), timex.Date(2025, 12, 29)))
+	})
+}
+
+func TestBusinessCalendar_WorkingDayOverrides(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 5, 31)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{{Date: timex.Date(2025, 4, 29), Summary: "昭和の日"}},
+		[]model.ClosedDay{{Date: timex.Date(2025, 5, 2), Summary: "休業日"}},
+		[]model.WorkingDayOverride{
+			{Date: timex.Date(2025, 4, 29), Summary: "棚卸"},
+			{Date: timex.Date(2025, 5, 10), Summary: "イベント出勤"},
+		},
+		nil,
+	)
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected model.DayStatus
+	}{
+		{
+			name:     "祝日より出勤日が優先される",
+			date:     timex.Date(2025, 4, 29),
+			expected: model.DayStatus{Date: timex.Date(2025, 4, 29), Kind: model.DayKindWorkingOverride, IsBusinessDay: true, Summary: "棚卸"},
+		},
+		{
+			name:     "週末より出勤日が優先される",
+			date:     timex.Date(2025, 5, 10),
+			expected: model.DayStatus{Date: timex.Date(2025, 5, 10), Kind: model.DayKindWorkingOverride, IsBusinessDay: true, Summary: "イベント出勤"},
+		},
+		{
+			name:     "出勤日のない休業日はそのまま",
+			date:     timex.Date(2025, 5, 2),
+			expected: model.DayStatus{Date: timex.Date(2025, 5, 2), Kind: model.DayKindClosedDay, Summary: "休業日"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, calendar.Status(tt.date))
+		})
+	}
+}
+
+func TestParseHolidayCategory(t *testing.T) {
+	t.Run("振替休日", func(t *testing.T) {
+		actual, err := model.ParseHolidayCategory("substitute")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.HolidayCategorySubstitute, actual)
+	})
+
+	t.Run("未知の種類はエラーになる", func(t *testing.T) {
+		_, err := model.ParseHolidayCategory("national_holiday")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestParseEntryKind(t *testing.T) {
+	t.Run("休日出勤", func(t *testing.T) {
+		actual, err := model.ParseEntryKind("working_day_override")
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.DayKindWorkingOverride, actual)
+	})
+
+	t.Run("一覧にない種類はエラーになる", func(t *testing.T) {
+		_, err := model.ParseEntryKind("business_day")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// BusinessDayIndex maps every date of a period to its business-day ordinal, so that business days
+// can be added and counted without iterating dates. Short days count as whole business days.
+type BusinessDayIndex struct {
+	period       timex.TimeRange
+	cumulative   []int32 // Number of business days from the beginning of the period up to and including each date
+	businessDays []int32 // Offsets from the beginning of the period of the business days in date order
+}
+
+// NewBusinessDayIndex builds the index of the period of the calendar
+func NewBusinessDayIndex(calendar *BusinessCalendar) *BusinessDayIndex {
+	period := timex.TimeRange{Begin: timex.DateOf(calendar.Period().Begin), End: timex.DateOf(calendar.Period().End)}
+	x := &BusinessDayIndex{period: period}
+
+	var count int32
+	for d, offset := period.Begin, int32(0); !d.After(period.End); d, offset = d.AddDate(0, 0, 1), offset+1 {
+		if calendar.IsBusinessDay(d) {
+			count++
+			x.businessDays = append(x.businessDays, offset)
+		}
+		x.cumulative = append(x.cumulative, count)
+	}
+	return x
+}
+
+// Period returns the period the index covers
+func (x *BusinessDayIndex) Period() timex.TimeRange {
+	return x.period
+}
+
+// Covers reports whether the index covers every date of the period
+func (x *BusinessDayIndex) Covers(period timex.TimeRange) bool {
+	return !timex.DateOf(period.Begin).Before(x.period.Begin) && !timex.DateOf(period.End).After(x.period.End)
+}
+
+// offset returns the number of days from the beginning of the period to the date, false when the date is outside it.
+// Days are counted on the calendar rather than by duration, as JST has no daylight saving time.
+func (x *BusinessDayIndex) offset(date time.Time) (int, bool) {
+	date = timex.DateOf(date)
+	if date.Before(x.period.Begin) || date.After(x.period.End) {
+		return 0, false
+	}
+	return int(date.Sub(x.period.Begin) / timex.DAY), true
+}
+
+// AddBusinessDays returns the nth business day after the date, or before it when n is negative.
+// The date itself does not count, whether or not it is a business day, and zero returns the date as it is.
+// ErrOutOfPeriod is returned when the date or the result is outside the period of the index.
+func (x *BusinessDayIndex) AddBusinessDays(date time.Time, n int) (time.Time, error) {
+	offset, ok := x.offset(date)
+	if !ok {
+		return time.Time{}, ErrOutOfPeriod
+	}
+	if n == 0 {
+		return timex.DateOf(date), nil
+	}
+
+	// Index in businessDays of the first business day after the date, and of the first one on or after it
+	next := int(x.cumulative[offset])
+	current := next
+	if x.isBusinessDay(offset) {
+		current--
+	}
+
+	i := next + n - 1
+	if n < 0 {
+		i = current + n
+	}
+	if i < 0 || i >= len(x.businessDays) {
+		return time.Time{}, ErrOutOfPeriod
+	}
+	return x.period.Begin.AddDate(0, 0, int(x.businessDays[i])), nil
+}
+
+func (x *BusinessDayIndex) isBusinessDay(offset int) bool {
+	if offset == 0 {
+		return x.cumulative[0] == 1
+	}
+	return x.cumulative[offset] > x.cumulative[offset-1]
+}
+
+// BusinessDaysBetween returns the number of business days after a up to and including b, negative when b is before a,
+// so that adding the result to a gives b when b is a business day.
+// ErrOutOfPeriod is returned when either date is outside the period of the index.
+func (x *BusinessDayIndex) BusinessDaysBetween(a, b time.Time) (int, error) {
+	i, ok := x.offset(a)
+	if !ok {
+		return 0, ErrOutOfPeriod
+	}
+	j, ok := x.offset(b)
+	if !ok {
+		return 0, ErrOutOfPeriod
+	}
+	return int(x.cumulative[j]) - int(x.cumulative[i]), nil
+}
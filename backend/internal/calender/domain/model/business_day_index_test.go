@@ -0,0 +1,80 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestBusinessDayIndex(t *testing.T) {
+	// 2025-05-03 to 2025-05-06 are national holidays, 2025-05-01 is a Thursday
+	period := timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 5, 31)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{
+			{Date: timex.Date(2025, 5, 3), Summary: "憲法記念日"},
+			{Date: timex.Date(2025, 5, 4), Summary: "みどりの日"},
+			{Date: timex.Date(2025, 5, 5), Summary: "こどもの日"},
+			{Date: timex.Date(2025, 5, 6), Summary: "休日"},
+		},
+		nil,
+		nil,
+		nil,
+	)
+	index := model.NewBusinessDayIndex(calendar)
+
+	addTests := []struct {
+		name     string
+		date     time.Time
+		n        int
+		expected time.Time
+	}{
+		{name: "営業日の翌営業日", date: timex.Date(2025, 5, 2), n: 1, expected: timex.Date(2025, 5, 7)},
+		{name: "休日の翌営業日", date: timex.Date(2025, 5, 4), n: 1, expected: timex.Date(2025, 5, 7)},
+		{name: "営業日の前営業日", date: timex.Date(2025, 5, 7), n: -1, expected: timex.Date(2025, 5, 2)},
+		{name: "休日の前営業日", date: timex.Date(2025, 5, 4), n: -1, expected: timex.Date(2025, 5, 2)},
+		{name: "期間の最初の日から数える", date: timex.Date(2025, 5, 1), n: 3, expected: timex.Date(2025, 5, 8)},
+		{name: "0日後はその日のまま", date: timex.Date(2025, 5, 4), n: 0, expected: timex.Date(2025, 5, 4)},
+	}
+	for _, tt := range addTests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := index.AddBusinessDays(tt.date, tt.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("期間外になる場合はエラーになる", func(t *testing.T) {
+		_, err := index.AddBusinessDays(timex.Date(2025, 5, 1), -1)
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+
+	t.Run("翌日から終了日までの営業日数が数えられる", func(t *testing.T) {
+		actual, err := index.BusinessDaysBetween(timex.Date(2025, 5, 2), timex.Date(2025, 5, 9))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, actual)
+	})
+
+	t.Run("逆順の場合は負の営業日数になる", func(t *testing.T) {
+		actual, err := index.BusinessDaysBetween(timex.Date(2025, 5, 9), timex.Date(2025, 5, 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, -3, actual)
+	})
+
+	t.Run("営業日数を足すと終了日に戻る", func(t *testing.T) {
+		days, err := index.BusinessDaysBetween(timex.Date(2025, 5, 4), timex.Date(2025, 5, 30))
+		assert.NoError(t, err)
+
+		actual, err := index.AddBusinessDays(timex.Date(2025, 5, 4), days)
+
+		assert.NoError(t, err)
+		assert.Equal(t, timex.Date(2025, 5, 30), actual)
+	})
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// ErrNoSuchBusinessDay is returned when a month has fewer business days than requested
+var ErrNoSuchBusinessDay = errors.New("no such business day in the month")
+
+// MonthOrdinal is the position of a date among the business days of its month
+type MonthOrdinal struct {
+	Date          time.Time
+	IsBusinessDay bool
+	Ordinal       int // 1 for the first business day of the month (第1営業日), 0 when the date is not a business day
+	FromEnd       int // 1 for the last business day of the month (月末最終営業日), 0 when the date is not a business day
+	BusinessDays  int // Number of business days in the month
+}
+
+// NthBusinessDay returns the nth business day of the month, counting from the end of the month when n is negative:
+// 1 is the first business day and -1 the last one. Short days count as business days.
+// ErrNoSuchBusinessDay is returned when the month has fewer business days and ErrOutOfPeriod when the month is not loaded.
+func (c *BusinessCalendar) NthBusinessDay(year int, month time.Month, n int) (time.Time, error) {
+	first, last := timex.Date(year, month, 1), lastDayOfMonth(year, month)
+	if !c.Contains(first) || !c.Contains(last) {
+		return time.Time{}, ErrOutOfPeriod
+	}
+	if n == 0 {
+		return time.Time{}, ErrNoSuchBusinessDay
+	}
+
+	start, step, count := first, 1, n
+	if n < 0 {
+		start, step, count = last, -1, -n
+	}
+	for d := start; d.Month() == month; d = d.AddDate(0, 0, step) {
+		if c.IsBusinessDay(d) {
+			count--
+			if count == 0 {
+				return d, nil
+			}
+		}
+	}
+	return time.Time{}, ErrNoSuchBusinessDay
+}
+
+// MonthOrdinal returns the position of the date among the business days of its month.
+// ErrOutOfPeriod is returned when the month of the date is not loaded.
+func (c *BusinessCalendar) MonthOrdinal(date time.Time) (MonthOrdinal, error) {
+	date = timex.DateOf(date)
+	first, last := timex.Date(date.Year(), date.Month(), 1), lastDayOfMonth(date.Year(), date.Month())
+	if !c.Contains(first) || !c.Contains(last) {
+		return MonthOrdinal{}, ErrOutOfPeriod
+	}
+
+	o := MonthOrdinal{Date: date, IsBusinessDay: c.IsBusinessDay(date)}
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if !c.IsBusinessDay(d) {
+			continue
+		}
+		o.BusinessDays++
+		if d.Equal(date) {
+			o.Ordinal = o.BusinessDays
+		}
+	}
+	if o.IsBusinessDay {
+		o.FromEnd = o.BusinessDays - o.Ordinal + 1
+	}
+	return o, nil
+}
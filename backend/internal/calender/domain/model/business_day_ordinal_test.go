@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestBusinessCalendar_NthBusinessDay(t *testing.T) {
+	// 2025-04-01 is a Tuesday and April 2025 has 21 business days
+	period := timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 4, 30)}
+	calendar := model.NewBusinessCalendar(period, []model.NationalHoliday{{Date: timex.Date(2025, 4, 29), Summary: "昭和の日"}}, nil, nil, nil)
+
+	tests := []struct {
+		name     string
+		n        int
+		expected time.Time
+	}{
+		{name: "第5営業日", n: 5, expected: timex.Date(2025, 4, 7)},
+		{name: "月末最終営業日", n: -1, expected: timex.Date(2025, 4, 30)},
+		{name: "月末から2番目の営業日は祝日を飛ばす", n: -2, expected: timex.Date(2025, 4, 28)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := calendar.NthBusinessDay(2025, time.April, tt.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("営業日数より大きい場合はエラーになる", func(t *testing.T) {
+		_, err := calendar.NthBusinessDay(2025, time.April, 22)
+
+		assert.ErrorIs(t, err, model.ErrNoSuchBusinessDay)
+	})
+
+	t.Run("読み込まれていない月はエラーになる", func(t *testing.T) {
+		_, err := calendar.NthBusinessDay(2025, time.May, 1)
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+}
+
+func TestBusinessCalendar_MonthOrdinal(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 4, 30)}
+	calendar := model.NewBusinessCalendar(period, []model.NationalHoliday{{Date: timex.Date(2025, 4, 29), Summary: "昭和の日"}}, nil, nil, nil)
+
+	t.Run("営業日の順番が前後から数えられる", func(t *testing.T) {
+		actual, err := calendar.MonthOrdinal(timex.Date(2025, 4, 28))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.MonthOrdinal{Date: timex.Date(2025, 4, 28), IsBusinessDay: true, Ordinal: 20, FromEnd: 2, BusinessDays: 21}, actual)
+	})
+
+	t.Run("休日の順番は0になる", func(t *testing.T) {
+		actual, err := calendar.MonthOrdinal(timex.Date(2025, 4, 29))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.MonthOrdinal{Date: timex.Date(2025, 4, 29), BusinessDays: 21}, actual)
+	})
+}
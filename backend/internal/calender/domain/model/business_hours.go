@@ -0,0 +1,200 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// ErrOutOfPeriod is returned when a calculation needs dates outside the period a calendar was loaded for
+var ErrOutOfPeriod = errors.New("date is outside the loaded period")
+
+// ErrPeriodTooLong is returned when a request covers more than a calculation is allowed to load
+var ErrPeriodTooLong = errors.New("too long to calculate")
+
+// DefaultTimeZone is the time zone of a calendar that does not specify one
+const DefaultTimeZone = "Asia/Tokyo"
+
+// OpeningHours is a span of a day during which business is conducted, as offsets from midnight
+type OpeningHours struct {
+	Opens  time.Duration
+	Closes time.Duration
+}
+
+// DefaultOpeningHours are the opening hours of every business day of a calendar without weekday hours
+var DefaultOpeningHours = []OpeningHours{{Opens: 9 * time.Hour, Closes: 18 * time.Hour}}
+
+// WeekdayHours are opening hours of a day of the week
+type WeekdayHours struct {
+	Weekday time.Weekday
+	OpeningHours
+}
+
+// DateHours are opening hours of a specific date, replacing the hours of its day of the week
+type DateHours struct {
+	Date time.Time
+	OpeningHours
+}
+
+// BusinessHours holds the opening hours of a calendar in its time zone
+type BusinessHours struct {
+	location   *time.Location
+	weekly     map[time.Weekday][]OpeningHours
+	exceptions map[string][]OpeningHours
+}
+
+// NewBusinessHours creates BusinessHours in the given location.
+// Every day of the week opens for DefaultOpeningHours when there are no weekday hours at all.
+func NewBusinessHours(location *time.Location, weekly []WeekdayHours, exceptions []DateHours) *BusinessHours {
+	h := &BusinessHours{
+		location:   location,
+		weekly:     map[time.Weekday][]OpeningHours{},
+		exceptions: map[string][]OpeningHours{},
+	}
+	for _, w := range weekly {
+		h.weekly[w.Weekday] = append(h.weekly[w.Weekday], w.OpeningHours)
+	}
+	for _, e := range exceptions {
+		key := dateKey(timex.DateOf(e.Date))
+		h.exceptions[key] = append(h.exceptions[key], e.OpeningHours)
+	}
+	for _, hours := range h.weekly {
+		sortOpeningHours(hours)
+	}
+	for _, hours := range h.exceptions {
+		sortOpeningHours(hours)
+	}
+	return h
+}
+
+// Location returns the time zone the opening hours are in
+func (h *BusinessHours) Location() *time.Location {
+	return h.location
+}
+
+// OpeningHours returns the opening hours of the date in order, ignoring whether it is a business day
+func (h *BusinessHours) OpeningHours(date time.Time) []OpeningHours {
+	if hours, ok := h.exceptions[dateKey(timex.DateOf(date))]; ok {
+		return hours
+	}
+	if len(h.weekly) == 0 {
+		return DefaultOpeningHours
+	}
+	return h.weekly[date.Weekday()]
+}
+
+func sortOpeningHours(hours []OpeningHours) {
+	sort.Slice(hours, func(i, j int) bool { return hours[i].Opens < hours[j].Opens })
+}
+
+// BusinessClock does arithmetic in business hours, skipping nights and every day that is not a business day
+type BusinessClock struct {
+	calendar *BusinessCalendar
+	hours    *BusinessHours
+}
+
+// NewBusinessClock creates a BusinessClock. Times of day are interpreted in the location of the business hours.
+func NewBusinessClock(calendar *BusinessCalendar, hours *BusinessHours) *BusinessClock {
+	return &BusinessClock{calendar: calendar, hours: hours}
+}
+
+// AddBusinessHours returns the time at which d of business hours have passed since t.
+// When d is used up exactly at closing time, the closing time is returned.
+// ErrOutOfPeriod is returned when the result is after the period of the calendar.
+func (c *BusinessClock) AddBusinessHours(t time.Time, d time.Duration) (time.Time, error) {
+	if d < 0 {
+		return time.Time{}, xerrors.Errorf("duration must not be negative: %s", d)
+	}
+
+	t = t.In(c.hours.location)
+	for day := c.midnight(t); ; day = day.AddDate(0, 0, 1) {
+		if !c.calendar.Contains(day) {
+			return time.Time{}, ErrOutOfPeriod
+		}
+
+		for _, span := range c.spans(day) {
+			start := span.Begin
+			if t.After(start) {
+				start = t
+			}
+			if !start.Before(span.End) {
+				continue
+			}
+			available := span.End.Sub(start)
+			if d <= available {
+				return start.Add(d), nil
+			}
+			d -= available
+		}
+	}
+}
+
+// BusinessDurationBetween returns the business hours between a and b, negative when b is before a.
+// ErrOutOfPeriod is returned when either time is outside the period of the calendar.
+func (c *BusinessClock) BusinessDurationBetween(a, b time.Time) (time.Duration, error) {
+	if b.Before(a) {
+		d, err := c.BusinessDurationBetween(b, a)
+		return -d, err
+	}
+
+	a, b = a.In(c.hours.location), b.In(c.hours.location)
+	if !c.calendar.Contains(c.midnight(a)) || !c.calendar.Contains(c.midnight(b)) {
+		return 0, ErrOutOfPeriod
+	}
+
+	var total time.Duration
+	for day := c.midnight(a); !day.After(b); day = day.AddDate(0, 0, 1) {
+		for _, span := range c.spans(day) {
+			start, end := span.Begin, span.End
+			if a.After(start) {
+				start = a
+			}
+			if b.Before(end) {
+				end = b
+			}
+			if start.Before(end) {
+				total += end.Sub(start)
+			}
+		}
+	}
+	return total, nil
+}
+
+// midnight returns the start of the day of t in the location of the business hours
+func (c *BusinessClock) midnight(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, c.hours.location)
+}
+
+// spans returns the opening hours of the day as times, none when it is not a business day.
+// The hours of a short day are cut to the time it opens and closes.
+func (c *BusinessClock) spans(day time.Time) []timex.TimeRange {
+	status := c.calendar.Status(day)
+	if !status.IsBusinessDay {
+		return nil
+	}
+
+	hours := c.hours.OpeningHours(day)
+	spans := make([]timex.TimeRange, 0, len(hours))
+	for _, h := range hours {
+		if status.OpensAt > h.Opens {
+			h.Opens = status.OpensAt
+		}
+		if status.ClosesAt > 0 && status.ClosesAt < h.Closes {
+			h.Closes = status.ClosesAt
+		}
+		if h.Opens < h.Closes {
+			spans = append(spans, timex.TimeRange{Begin: c.at(day, h.Opens), End: c.at(day, h.Closes)})
+		}
+	}
+	return spans
+}
+
+// at returns the time of day of the given offset from midnight, keeping wall clock time across DST changes
+func (c *BusinessClock) at(day time.Time, offset time.Duration) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, 0, 0, int(offset/time.Second), 0, c.hours.location)
+}
@@ -0,0 +1,102 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestBusinessClock(t *testing.T) {
+	// 2025-04-25 is a Friday and 2025-04-29 a national holiday
+	period := timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 4, 30)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{{Date: timex.Date(2025, 4, 29), Summary: "昭和の日"}},
+		[]model.ClosedDay{
+			{Date: timex.Date(2025, 4, 28), Summary: "休業日"},
+			{Date: timex.Date(2025, 4, 18), Summary: "午前休業", OpensAt: 13 * time.Hour},
+		},
+		nil,
+		nil,
+	)
+	hours := model.NewBusinessHours(
+		timex.JST,
+		[]model.WeekdayHours{
+			{Weekday: time.Monday, OpeningHours: model.OpeningHours{Opens: 9 * time.Hour, Closes: 12 * time.Hour}},
+			{Weekday: time.Monday, OpeningHours: model.OpeningHours{Opens: 13 * time.Hour, Closes: 18 * time.Hour}},
+			{Weekday: time.Tuesday, OpeningHours: model.OpeningHours{Opens: 9 * time.Hour, Closes: 18 * time.Hour}},
+			{Weekday: time.Wednesday, OpeningHours: model.OpeningHours{Opens: 9 * time.Hour, Closes: 18 * time.Hour}},
+			{Weekday: time.Thursday, OpeningHours: model.OpeningHours{Opens: 9 * time.Hour, Closes: 18 * time.Hour}},
+			{Weekday: time.Friday, OpeningHours: model.OpeningHours{Opens: 9 * time.Hour, Closes: 18 * time.Hour}},
+		},
+		[]model.DateHours{
+			{Date: timex.Date(2025, 4, 30), OpeningHours: model.OpeningHours{Opens: 10 * time.Hour, Closes: 15 * time.Hour}},
+		},
+	)
+	clock := model.NewBusinessClock(calendar, hours)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, 4, day, hour, minute, 0, 0, timex.JST)
+	}
+
+	t.Run("AddBusinessHours", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			from     time.Time
+			duration time.Duration
+			expected time.Time
+		}{
+			{name: "同じ日のうちに終わる", from: at(24, 10, 0), duration: 3 * time.Hour, expected: at(24, 13, 0)},
+			{name: "営業時間前は開始時刻から数える", from: at(24, 7, 0), duration: time.Hour, expected: at(24, 10, 0)},
+			{name: "ちょうど終業時刻に終わる", from: at(24, 17, 0), duration: time.Hour, expected: at(24, 18, 0)},
+			{name: "休憩時間を飛ばす", from: at(21, 11, 0), duration: 2 * time.Hour, expected: at(21, 14, 0)},
+			{name: "短縮営業日は営業時間が短くなる", from: at(18, 9, 0), duration: 6 * time.Hour, expected: at(21, 10, 0)},
+			{name: "週末と休業日と祝日を飛ばす", from: at(25, 17, 0), duration: 3 * time.Hour, expected: at(30, 12, 0)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				actual, err := clock.AddBusinessHours(tt.from, tt.duration)
+
+				assert.NoError(t, err)
+				assert.True(t, tt.expected.Equal(actual), "expected %s, got %s", tt.expected, actual)
+			})
+		}
+	})
+
+	t.Run("別のタイムゾーンの時刻はカレンダーのタイムゾーンで解釈される", func(t *testing.T) {
+		actual, err := clock.AddBusinessHours(time.Date(2025, 4, 24, 0, 0, 0, 0, time.UTC), time.Hour)
+
+		assert.NoError(t, err)
+		assert.True(t, at(24, 10, 0).Equal(actual))
+	})
+
+	t.Run("期間を超える場合はエラーになる", func(t *testing.T) {
+		_, err := clock.AddBusinessHours(at(30, 10, 0), 10*time.Hour)
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+
+	t.Run("BusinessDurationBetween", func(t *testing.T) {
+		actual, err := clock.BusinessDurationBetween(at(25, 17, 0), at(30, 12, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3*time.Hour, actual)
+	})
+
+	t.Run("逆順の場合は負になる", func(t *testing.T) {
+		actual, err := clock.BusinessDurationBetween(at(21, 13, 0), at(21, 11, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, -time.Hour, actual)
+	})
+}
+
+func TestBusinessHours_OpeningHours(t *testing.T) {
+	t.Run("曜日の営業時間がなければ既定の営業時間になる", func(t *testing.T) {
+		hours := model.NewBusinessHours(timex.JST, nil, nil)
+
+		assert.Equal(t, model.DefaultOpeningHours, hours.OpeningHours(timex.Date(2025, 4, 26)))
+	})
+}
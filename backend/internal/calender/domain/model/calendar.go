@@ -0,0 +1,31 @@
+package model
+
+import "errors"
+
+// ErrCalendarCycle is returned when a calendar is its own ancestor
+var ErrCalendarCycle = errors.New("calendar inherits from itself")
+
+// Calendar is a named set of closed days, such as the calendar of a factory or an office.
+// A calendar with a parent inherits the closed days of the parent.
+type Calendar struct {
+	ID       string
+	Name     string
+	ParentID string // Identifier of the parent calendar, empty for a calendar without one
+	TimeZone string // IANA time zone business hours are in, e.g. "Asia/Tokyo"
+}
+
+// HasParent reports whether the calendar inherits from another calendar
+func (c Calendar) HasParent() bool {
+	return c.ParentID != ""
+}
+
+// CalendarLayer is what a single calendar adds to and removes from the closed days it inherits
+type CalendarLayer struct {
+	Calendar            Calendar
+	ClosedDays          []ClosedDay          // Closed days added by the calendar
+	ClosedDayRules      []ClosedDayRule      // Recurring closed days added by the calendar
+	WorkingDayOverrides []WorkingDayOverride // Inherited closed days the calendar opens on
+	WeeklyPatterns      []WeeklyPattern      // Weekly patterns replacing the inherited ones when not empty
+	WeekdayHours        []WeekdayHours       // Opening hours replacing the inherited ones when not empty
+	DateHours           []DateHours          // Opening hours of specific dates, replacing inherited ones of the same date
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/icalx"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// ClosedDayRule is a recurring closure, such as every second and fourth Saturday or 12/29 to 1/3 every year
+type ClosedDayRule struct {
+	ID                   int64
+	Summary              string
+	Category             HolidayCategory
+	Names                LocalizedNames // Names of the closed days by language
+	StartsOn             time.Time      // Start of the recurrence (DTSTART), the first occurrence unless AfterNationalHoliday is set
+	RRule                string         // Recurrence rule (RFC 5545), e.g. "FREQ=MONTHLY;BYDAY=2SA,4SA"
+	DurationDays         int            // Number of consecutive days closed from each occurrence
+	AfterNationalHoliday bool           // Whether only the first occurrence after each national holiday is closed
+	Exceptions           []time.Time    // Dates the rule does not close
+}
+
+// ClosedDayRulePeriod returns the period national holidays must be loaded for
+// to expand rules relative to national holidays within the given period
+func ClosedDayRulePeriod(period timex.TimeRange) timex.TimeRange {
+	return timex.TimeRange{Begin: period.Begin.AddDate(-1, 0, 0), End: period.End}
+}
+
+// Expand returns the closed days of the rule within the period in date order.
+// nationalHolidays must cover ClosedDayRulePeriod(period) when the rule is relative to national holidays.
+func (r ClosedDayRule) Expand(period timex.TimeRange, nationalHolidays []NationalHoliday) ([]ClosedDay, error) {
+	recur, err := icalx.ParseRecur(r.RRule)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid rule %d: %w", r.ID, err)
+	}
+
+	begin, end := timex.DateOf(period.Begin), timex.DateOf(period.End)
+	starts := recur.Expand(timex.DateOf(r.StartsOn), end)
+	if r.AfterNationalHoliday {
+		starts = firstAfterHolidays(starts, nationalHolidays)
+	}
+
+	exceptions := make(map[string]bool, len(r.Exceptions))
+	for _, e := range r.Exceptions {
+		exceptions[dateKey(timex.DateOf(e))] = true
+	}
+
+	days := r.DurationDays
+	if days < 1 {
+		days = 1
+	}
+
+	seen := map[string]bool{}
+	var closedDays []ClosedDay
+	for _, start := range starts {
+		for i := 0; i < days; i++ {
+			date := start.AddDate(0, 0, i)
+			key := dateKey(date)
+			if date.Before(begin) || date.After(end) || exceptions[key] || seen[key] {
+				continue
+			}
+			seen[key] = true
+			closedDays = append(closedDays, ClosedDay{Date: date, Summary: r.Summary, Category: r.Category, Names: r.Names})
+		}
+	}
+
+	sort.Slice(closedDays, func(i, j int) bool { return closedDays[i].Date.Before(closedDays[j].Date) })
+	return closedDays, nil
+}
+
+// firstAfterHolidays returns, for every national holiday, the first occurrence after it.
+// Holidays followed by the same occurrence select it once.
+func firstAfterHolidays(occurrences []time.Time, nationalHolidays []NationalHoliday) []time.Time {
+	seen := map[string]bool{}
+	var selected []time.Time
+	for _, h := range nationalHolidays {
+		date := timex.DateOf(h.Date)
+		i := sort.Search(len(occurrences), func(i int) bool { return occurrences[i].After(date) })
+		if i == len(occurrences) {
+			continue
+		}
+
+		key := dateKey(occurrences[i])
+		if !seen[key] {
+			seen[key] = true
+			selected = append(selected, occurrences[i])
+		}
+	}
+	return selected
+}
@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestClosedDayRule_Expand(t *testing.T) {
+	t.Run("年末年始の連続した休業日が期間内に展開される", func(t *testing.T) {
+		rule := model.ClosedDayRule{
+			Summary:      "年末年始休業",
+			StartsOn:     timex.Date(2020, 12, 29),
+			RRule:        "FREQ=YEARLY",
+			DurationDays: 6,
+			Exceptions:   []time.Time{timex.Date(2025, 12, 30)},
+		}
+
+		actual, err := rule.Expand(timex.TimeRange{Begin: timex.Date(2025, 1, 2), End: timex.Date(2025, 12, 31)}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 1, 2), Summary: "年末年始休業"},
+			{Date: timex.Date(2025, 1, 3), Summary: "年末年始休業"},
+			{Date: timex.Date(2025, 12, 29), Summary: "年末年始休業"},
+			{Date: timex.Date(2025, 12, 31), Summary: "年末年始休業"},
+		}, actual)
+	})
+
+	t.Run("祝日の後の最初の水曜日が休業日になる", func(t *testing.T) {
+		rule := model.ClosedDayRule{
+			Summary:              "振替休業",
+			StartsOn:             timex.Date(2025, 1, 1),
+			RRule:                "FREQ=WEEKLY;BYDAY=WE",
+			DurationDays:         1,
+			AfterNationalHoliday: true,
+		}
+		holidays := []model.NationalHoliday{
+			{Date: timex.Date(2025, 4, 29), Summary: "昭和の日"},
+			{Date: timex.Date(2025, 5, 3), Summary: "憲法記念日"},
+			{Date: timex.Date(2025, 5, 5), Summary: "こどもの日"},
+			{Date: timex.Date(2025, 5, 6), Summary: "振替休日"},
+		}
+
+		actual, err := rule.Expand(timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 5, 31)}, holidays)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 4, 30), Summary: "振替休業"},
+			{Date: timex.Date(2025, 5, 7), Summary: "振替休業"},
+		}, actual)
+	})
+
+	t.Run("不正な規則はエラーになる", func(t *testing.T) {
+		rule := model.ClosedDayRule{StartsOn: timex.Date(2025, 1, 1), RRule: "FREQ=HOURLY"}
+
+		_, err := rule.Expand(timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 1, 31)}, nil)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"maps"
+	"sort"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// Closure is a run of consecutive closed days sharing a summary, such as a nine-day summer closure,
+// stored as a single date range so that it is edited as a whole
+type Closure struct {
+	StartsOn time.Time // First closed day
+	EndsOn   time.Time // Last closed day, inclusive
+	Summary  string
+	Category HolidayCategory
+	Names    LocalizedNames
+	OpensAt  time.Duration // For a partial closure, the time business starts on each day, zero when it starts as usual
+	ClosesAt time.Duration // For a partial closure, the time business ends on each day, zero when it ends as usual
+}
+
+// Period returns the days of the closure
+func (c Closure) Period() timex.TimeRange {
+	return timex.TimeRange{Begin: timex.DateOf(c.StartsOn), End: timex.DateOf(c.EndsOn)}
+}
+
+// Days returns the closed days of the closure within the period in date order
+func (c Closure) Days(period timex.TimeRange) []ClosedDay {
+	begin, end := timex.DateOf(c.StartsOn), timex.DateOf(c.EndsOn)
+	if b := timex.DateOf(period.Begin); b.After(begin) {
+		begin = b
+	}
+	if e := timex.DateOf(period.End); e.Before(end) {
+		end = e
+	}
+
+	var days []ClosedDay
+	for d := begin; !d.After(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, ClosedDay{
+			Date:     d,
+			Summary:  c.Summary,
+			Category: c.Category,
+			Names:    c.Names,
+			OpensAt:  c.OpensAt,
+			ClosesAt: c.ClosesAt,
+		})
+	}
+	return days
+}
+
+// Without returns what remains of the closure when the days of the period are removed from it:
+// nothing, the closure itself, a shortened closure or the two closures on either side of the period
+func (c Closure) Without(period timex.TimeRange) []Closure {
+	begin, end := timex.DateOf(period.Begin), timex.DateOf(period.End)
+	startsOn, endsOn := timex.DateOf(c.StartsOn), timex.DateOf(c.EndsOn)
+	if end.Before(startsOn) || begin.After(endsOn) {
+		return []Closure{c}
+	}
+
+	var rest []Closure
+	if begin.After(startsOn) {
+		before := c
+		before.StartsOn, before.EndsOn = startsOn, begin.AddDate(0, 0, -1)
+		rest = append(rest, before)
+	}
+	if end.Before(endsOn) {
+		after := c
+		after.StartsOn, after.EndsOn = end.AddDate(0, 0, 1), endsOn
+		rest = append(rest, after)
+	}
+	return rest
+}
+
+// NewClosures groups closed days into closures of consecutive days with the same summary, category, names and hours.
+// Closures are returned in date order; a date closed more than once keeps its first closed day.
+func NewClosures(closedDays []ClosedDay) []Closure {
+	sorted := make([]ClosedDay, len(closedDays))
+	copy(sorted, closedDays)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	var closures []Closure
+	for _, d := range sorted {
+		date := timex.DateOf(d.Date)
+		if n := len(closures); n > 0 {
+			last := &closures[n-1]
+			if !date.After(last.EndsOn) {
+				continue
+			}
+			if date.Equal(last.EndsOn.AddDate(0, 0, 1)) && last.sameAs(d) {
+				last.EndsOn = date
+				continue
+			}
+		}
+		closures = append(closures, Closure{
+			StartsOn: date,
+			EndsOn:   date,
+			Summary:  d.Summary,
+			Category: d.Category,
+			Names:    d.Names,
+			OpensAt:  d.OpensAt,
+			ClosesAt: d.ClosesAt,
+		})
+	}
+	return closures
+}
+
+// sameAs reports whether the closed day can extend the closure
+func (c Closure) sameAs(d ClosedDay) bool {
+	return c.Summary == d.Summary && c.Category == d.Category && maps.Equal(c.Names, d.Names) &&
+		c.OpensAt == d.OpensAt && c.ClosesAt == d.ClosesAt
+}
@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestNewClosures(t *testing.T) {
+	t.Run("連続する同じ休業日はひとつの期間になる", func(t *testing.T) {
+		actual := model.NewClosures([]model.ClosedDay{
+			{Date: timex.Date(2025, 8, 14), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 13), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 15), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 18), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 19), Summary: "棚卸"},
+		})
+
+		assert.Equal(t, []model.Closure{
+			{StartsOn: timex.Date(2025, 8, 13), EndsOn: timex.Date(2025, 8, 15), Summary: "夏季休業"},
+			{StartsOn: timex.Date(2025, 8, 18), EndsOn: timex.Date(2025, 8, 18), Summary: "夏季休業"},
+			{StartsOn: timex.Date(2025, 8, 19), EndsOn: timex.Date(2025, 8, 19), Summary: "棚卸"},
+		}, actual)
+	})
+
+	t.Run("同じ日付は最初の休業日が使われる", func(t *testing.T) {
+		actual := model.NewClosures([]model.ClosedDay{
+			{Date: timex.Date(2025, 8, 13), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 13), Summary: "棚卸"},
+		})
+
+		assert.Equal(t, []model.Closure{
+			{StartsOn: timex.Date(2025, 8, 13), EndsOn: timex.Date(2025, 8, 13), Summary: "夏季休業"},
+		}, actual)
+	})
+}
+
+func TestClosure(t *testing.T) {
+	closure := model.Closure{StartsOn: timex.Date(2025, 8, 9), EndsOn: timex.Date(2025, 8, 17), Summary: "夏季休業"}
+
+	t.Run("期間内の日付に展開される", func(t *testing.T) {
+		actual := closure.Days(timex.TimeRange{Begin: timex.Date(2025, 8, 16), End: timex.Date(2025, 8, 31)})
+
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 8, 16), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 17), Summary: "夏季休業"},
+		}, actual)
+	})
+
+	withoutTests := []struct {
+		name     string
+		period   timex.TimeRange
+		expected []model.Closure
+	}{
+		{
+			name:     "重ならない期間はそのまま残る",
+			period:   timex.TimeRange{Begin: timex.Date(2025, 8, 18), End: timex.Date(2025, 8, 20)},
+			expected: []model.Closure{closure},
+		},
+		{
+			name:   "後ろが重なると短くなる",
+			period: timex.TimeRange{Begin: timex.Date(2025, 8, 16), End: timex.Date(2025, 8, 20)},
+			expected: []model.Closure{
+				{StartsOn: timex.Date(2025, 8, 9), EndsOn: timex.Date(2025, 8, 15), Summary: "夏季休業"},
+			},
+		},
+		{
+			name:   "途中が重なると分割される",
+			period: timex.TimeRange{Begin: timex.Date(2025, 8, 12), End: timex.Date(2025, 8, 12)},
+			expected: []model.Closure{
+				{StartsOn: timex.Date(2025, 8, 9), EndsOn: timex.Date(2025, 8, 11), Summary: "夏季休業"},
+				{StartsOn: timex.Date(2025, 8, 13), EndsOn: timex.Date(2025, 8, 17), Summary: "夏季休業"},
+			},
+		},
+		{
+			name:     "全体が重なると残らない",
+			period:   timex.TimeRange{Begin: timex.Date(2025, 8, 1), End: timex.Date(2025, 8, 31)},
+			expected: nil,
+		},
+	}
+	for _, tt := range withoutTests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, closure.Without(tt.period))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// HolidayCategory classifies a holiday or closed day by its legal basis
+type HolidayCategory string
+
+const (
+	HolidayCategoryStatutory  HolidayCategory = "statutory"  // 国民の祝日
+	HolidayCategorySubstitute HolidayCategory = "substitute" // 振替休日
+	HolidayCategoryCitizens   HolidayCategory = "citizens"   // 国民の休日
+	HolidayCategorySpecial    HolidayCategory = "special"    // 即位の礼など、その年限りの特別な休日
+	HolidayCategoryCompany    HolidayCategory = "company"    // 会社が定める休業日
+)
+
+// ParseHolidayCategory parses a holiday category such as "substitute"
+func ParseHolidayCategory(s string) (HolidayCategory, error) {
+	switch c := HolidayCategory(s); c {
+	case HolidayCategoryStatutory, HolidayCategorySubstitute, HolidayCategoryCitizens, HolidayCategorySpecial, HolidayCategoryCompany:
+		return c, nil
+	default:
+		return "", xerrors.Errorf("invalid holiday category: %s", s)
+	}
+}
+
+// NationalHoliday is a statutory holiday shared by every calendar
+type NationalHoliday struct {
+	Date     time.Time
+	Summary  string
+	Category HolidayCategory
+	Names    LocalizedNames
+}
+
+// Name returns the name of the holiday in the language
+func (h NationalHoliday) Name(lang Language) string {
+	return Localize(lang, h.Summary, h.Category, h.Names)
+}
+
+// ClosedDay is a day on which the company is closed, or only open for part of the day
+type ClosedDay struct {
+	Date     time.Time
+	Summary  string
+	Category HolidayCategory
+	Names    LocalizedNames
+	OpensAt  time.Duration // For a partial closure, the time business starts as an offset from midnight, zero when it starts as usual
+	ClosesAt time.Duration // For a partial closure, the time business ends as an offset from midnight, zero when it ends as usual
+}
+
+// Name returns the name of the closed day in the language
+func (d ClosedDay) Name(lang Language) string {
+	return Localize(lang, d.Summary, d.Category, d.Names)
+}
+
+// IsPartial reports whether the company is open for part of the day
+func (d ClosedDay) IsPartial() bool {
+	return d.OpensAt > 0 || d.ClosesAt > 0
+}
+
+// WorkingDayOverride makes a date a business day of a calendar even when it is a national holiday,
+// a weekend or a closed day inherited from the parent calendar
+type WorkingDayOverride struct {
+	Date    time.Time
+	Summary string
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/xerrors"
+)
+
+// Language is a primary language subtag such as "ja" or "en"
+type Language string
+
+const (
+	LanguageJapanese Language = "ja"
+	LanguageEnglish  Language = "en"
+)
+
+// DefaultLanguage is the language of summaries
+const DefaultLanguage = LanguageJapanese
+
+// SupportedLanguages are the languages names can be requested in, the default language first
+var SupportedLanguages = []Language{LanguageJapanese, LanguageEnglish}
+
+// languageMatcher picks the closest supported language for a requested one
+var languageMatcher = language.NewMatcher(languageTags())
+
+func languageTags() []language.Tag {
+	tags := make([]language.Tag, 0, len(SupportedLanguages))
+	for _, l := range SupportedLanguages {
+		tags = append(tags, language.Make(string(l)))
+	}
+	return tags
+}
+
+// MatchLanguage returns the supported language closest to the tags, given in order of preference.
+// It reports false when none of them is close to a supported language.
+func MatchLanguage(tags ...language.Tag) (Language, bool) {
+	_, i, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage, false
+	}
+	return SupportedLanguages[i], true
+}
+
+// ParseLanguage parses a language tag such as "en" or "en-US" into the closest supported language
+func ParseLanguage(s string) (Language, error) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return "", xerrors.Errorf("invalid language: %s", s)
+	}
+	lang, ok := MatchLanguage(tag)
+	if !ok {
+		return "", xerrors.Errorf("unsupported language: %s", s)
+	}
+	return lang, nil
+}
+
+// LocalizedNames holds the names of a holiday or closed day by language, in addition to its Japanese summary
+type LocalizedNames map[Language]string
+
+// Localize returns the name of a day in the language.
+// A stored name takes precedence, then the generated English name of a national holiday, then the Japanese summary.
+func Localize(lang Language, summary string, category HolidayCategory, names LocalizedNames) string {
+	if name, ok := names[lang]; ok && name != "" {
+		return name
+	}
+	if lang == LanguageEnglish {
+		if name := englishHolidayName(summary, category); name != "" {
+			return name
+		}
+	}
+	return summary
+}
+
+// englishHolidayNames are the English names of the national holidays by their Japanese names,
+// following the Cabinet Office's English translation of the Act on National Holidays
+var englishHolidayNames = map[string]string{
+	"元日":     "New Year's Day",
+	"成人の日":   "Coming of Age Day",
+	"建国記念の日": "National Foundation Day",
+	"天皇誕生日":  "The Emperor's Birthday",
+	"春分の日":   "Vernal Equinox Day",
+	"昭和の日":   "Showa Day",
+	"憲法記念日":  "Constitution Memorial Day",
+	"みどりの日":  "Greenery Day",
+	"こどもの日":  "Children's Day",
+	"海の日":    "Marine Day",
+	"山の日":    "Mountain Day",
+	"敬老の日":   "Respect for the Aged Day",
+	"秋分の日":   "Autumnal Equinox Day",
+	"体育の日":   "Health and Sports Day",
+	"スポーツの日": "Sports Day",
+	"文化の日":   "Culture Day",
+	"勤労感謝の日": "Labor Thanksgiving Day",
+}
+
+// englishHolidayName generates the English name of a national holiday, empty when it is not known.
+// Substitute and citizens' holidays are named by their category, whatever their summary.
+func englishHolidayName(summary string, category HolidayCategory) string {
+	switch category {
+	case HolidayCategorySubstitute:
+		return "Substitute Holiday"
+	case HolidayCategoryCitizens:
+		return "Citizens' Holiday"
+	case HolidayCategoryStatutory, HolidayCategorySpecial:
+		return englishHolidayNames[summary]
+	default:
+		return ""
+	}
+}
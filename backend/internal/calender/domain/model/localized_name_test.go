@@ -0,0 +1,55 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     model.Language
+		summary  string
+		category model.HolidayCategory
+		names    model.LocalizedNames
+		expected string
+	}{
+		{name: "日本語は件名のまま", lang: model.LanguageJapanese, summary: "元日", category: model.HolidayCategoryStatutory, expected: "元日"},
+		{name: "登録された名前が優先される", lang: model.LanguageEnglish, summary: "元日", category: model.HolidayCategoryStatutory, names: model.LocalizedNames{model.LanguageEnglish: "New Year"}, expected: "New Year"},
+		{name: "祝日の英語名が生成される", lang: model.LanguageEnglish, summary: "スポーツの日", category: model.HolidayCategoryStatutory, expected: "Sports Day"},
+		{name: "振替休日は件名によらず英語名が生成される", lang: model.LanguageEnglish, summary: "休日", category: model.HolidayCategorySubstitute, expected: "Substitute Holiday"},
+		{name: "国民の休日の英語名が生成される", lang: model.LanguageEnglish, summary: "国民の休日", category: model.HolidayCategoryCitizens, expected: "Citizens' Holiday"},
+		{name: "英語名のない休業日は件名になる", lang: model.LanguageEnglish, summary: "年末年始休業", category: model.HolidayCategoryCompany, expected: "年末年始休業"},
+		{name: "休業日は祝日と同じ件名でも英語名が生成されない", lang: model.LanguageEnglish, summary: "元日", category: model.HolidayCategoryCompany, expected: "元日"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, model.Localize(tt.lang, tt.summary, tt.category, tt.names))
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	t.Run("大文字や地域付きの言語も対応する言語になる", func(t *testing.T) {
+		for _, s := range []string{"en", "EN", "en-US"} {
+			actual, err := model.ParseLanguage(s)
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.LanguageEnglish, actual)
+		}
+	})
+
+	t.Run("対応していない言語はエラーになる", func(t *testing.T) {
+		_, err := model.ParseLanguage("fr")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("言語タグでない値はエラーになる", func(t *testing.T) {
+		_, err := model.ParseLanguage("日本語")
+
+		assert.Error(t, err)
+	})
+}
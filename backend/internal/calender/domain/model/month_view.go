@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// CalendarDay is a cell of a month grid
+type CalendarDay struct {
+	DayStatus
+	InMonth bool // Whether the day belongs to the displayed month rather than an adjacent one
+}
+
+// MonthView is a month laid out as whole weeks, including the leading and trailing days of adjacent months
+type MonthView struct {
+	Year         int
+	Month        time.Month
+	WeekStart    time.Weekday
+	Weeks        [][]CalendarDay
+	BusinessDays float64 // Business days of the month, counting short days by their fraction
+}
+
+// MonthGridPeriod returns the period covered by the grid of the month, from the first day of its first week to the last day of its last week
+func MonthGridPeriod(year int, month time.Month, weekStart time.Weekday) timex.TimeRange {
+	first := timex.Date(year, month, 1)
+	last := first.AddDate(0, 1, -1)
+
+	begin := first.AddDate(0, 0, -((int(first.Weekday()) - int(weekStart) + 7) % 7))
+	end := last.AddDate(0, 0, (int(weekStart)+6-int(last.Weekday())+7)%7)
+
+	return timex.TimeRange{Begin: begin, End: end}
+}
+
+// NewMonthView lays out the month using the statuses of the calendar, which must contain the grid period
+func NewMonthView(calendar *BusinessCalendar, year int, month time.Month, weekStart time.Weekday) MonthView {
+	view := MonthView{Year: year, Month: month, WeekStart: weekStart}
+
+	period := MonthGridPeriod(year, month, weekStart)
+	var week []CalendarDay
+	for d := period.Begin; !d.After(period.End); d = d.AddDate(0, 0, 1) {
+		day := CalendarDay{
+			DayStatus: calendar.Status(d),
+			InMonth:   d.Month() == month,
+		}
+		if day.InMonth {
+			view.BusinessDays += day.Fraction()
+		}
+
+		week = append(week, day)
+		if len(week) == 7 {
+			view.Weeks = append(view.Weeks, week)
+			week = nil
+		}
+	}
+
+	return view
+}
@@ -0,0 +1,61 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestMonthGridPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		weekStart time.Weekday
+		expected  timex.TimeRange
+	}{
+		{
+			name:      "日曜始まり",
+			weekStart: time.Sunday,
+			expected:  timex.TimeRange{Begin: timex.Date(2024, 12, 29), End: timex.Date(2025, 2, 1)},
+		},
+		{
+			name:      "月曜始まり",
+			weekStart: time.Monday,
+			expected:  timex.TimeRange{Begin: timex.Date(2024, 12, 30), End: timex.Date(2025, 2, 2)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := model.MonthGridPeriod(2025, time.January, tt.weekStart)
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNewMonthView(t *testing.T) {
+	t.Run("前後の月の日を含む週単位のグリッドが作成される", func(t *testing.T) {
+		period := model.MonthGridPeriod(2025, time.January, time.Sunday)
+		calendar := model.NewBusinessCalendar(period, []model.NationalHoliday{{Date: timex.Date(2025, 1, 1), Summary: "元日"}}, nil, nil, nil)
+
+		actual := model.NewMonthView(calendar, 2025, time.January, time.Sunday)
+
+		assert.Equal(t, 5, len(actual.Weeks))
+		for _, week := range actual.Weeks {
+			assert.Equal(t, 7, len(week))
+			assert.Equal(t, time.Sunday, week[0].Weekday())
+		}
+
+		first := actual.Weeks[0][0]
+		assert.Equal(t, timex.Date(2024, 12, 29), first.Date)
+		assert.False(t, first.InMonth)
+
+		newYear := actual.Weeks[0][3]
+		assert.True(t, newYear.InMonth)
+		assert.Equal(t, model.DayKindNationalHoliday, newYear.Kind)
+		assert.Equal(t, "元日", newYear.Summary)
+	})
+}
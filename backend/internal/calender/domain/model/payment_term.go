@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// MaxPaymentMonthOffset is the largest number of months a payment can be made after closing
+const MaxPaymentMonthOffset = 12
+
+// PaymentTerm is a Japanese payment term such as 月末締め翌月末払い or 20日締め翌月10日払い、休日の場合は前営業日
+type PaymentTerm struct {
+	ClosingDay         int            // Day of the month transactions are closed on from 1 to 31, the last day of the month for months shorter than that
+	PaymentMonthOffset int            // Months from the closing month to the payment month, 1 for 翌月
+	PaymentDay         int            // Day of the month payments are made on from 1 to 31, the last day of the month for months shorter than that
+	Adjustment         RollConvention // Adjustment of payment dates falling on non-business days, none when empty
+}
+
+// Validate reports whether the days are days of a month and payments are not made before closing
+func (t PaymentTerm) Validate() error {
+	if t.ClosingDay < 1 || t.ClosingDay > 31 {
+		return xerrors.Errorf("invalid closing day: %d", t.ClosingDay)
+	}
+	if t.PaymentDay < 1 || t.PaymentDay > 31 {
+		return xerrors.Errorf("invalid payment day: %d", t.PaymentDay)
+	}
+	if t.PaymentMonthOffset < 0 || t.PaymentMonthOffset > MaxPaymentMonthOffset {
+		return xerrors.Errorf("payment month offset must be from 0 to %d: %d", MaxPaymentMonthOffset, t.PaymentMonthOffset)
+	}
+	if t.PaymentMonthOffset == 0 && t.PaymentDay < t.ClosingDay {
+		return xerrors.Errorf("payment day %d is before closing day %d of the same month", t.PaymentDay, t.ClosingDay)
+	}
+	if t.Adjustment != "" {
+		if _, err := ParseRollConvention(string(t.Adjustment)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PaymentDates are the closing and payment dates of a transaction
+type PaymentDates struct {
+	Transaction       time.Time
+	Closing           time.Time // The first closing date on or after the transaction
+	UnadjustedPayment time.Time
+	Payment           time.Time // UnadjustedPayment adjusted to a business day by the adjustment of the term
+}
+
+// Dates returns the closing and payment dates of a transaction made on the date.
+// The calendar must cover the payment date and the business day it is adjusted to.
+func (t PaymentTerm) Dates(transaction time.Time, calendar *BusinessCalendar) (PaymentDates, error) {
+	transaction = timex.DateOf(transaction)
+
+	closing := dayOfMonth(transaction.Year(), transaction.Month(), t.ClosingDay)
+	if transaction.After(closing) {
+		next := timex.Date(transaction.Year(), transaction.Month()+1, 1)
+		closing = dayOfMonth(next.Year(), next.Month(), t.ClosingDay)
+	}
+
+	month := timex.Date(closing.Year(), closing.Month()+time.Month(t.PaymentMonthOffset), 1)
+	payment := dayOfMonth(month.Year(), month.Month(), t.PaymentDay)
+
+	adjusted := payment
+	if t.Adjustment != "" {
+		var err error
+		if adjusted, err = Roll(payment, t.Adjustment, calendar); err != nil {
+			return PaymentDates{}, err
+		}
+	}
+
+	return PaymentDates{Transaction: transaction, Closing: closing, UnadjustedPayment: payment, Payment: adjusted}, nil
+}
+
+// dayOfMonth returns the day of the month, or the last day of the month when the month is shorter than that
+func dayOfMonth(year int, month time.Month, day int) time.Time {
+	return timex.Date(year, month, min(day, lastDayOfMonth(year, month).Day()))
+}
@@ -0,0 +1,103 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestPaymentTerm_Dates(t *testing.T) {
+	// 2025-05-10 and 2025-05-31 are Saturdays
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 7, 31)}
+	calendar := model.NewBusinessCalendar(period, nil, nil, nil, nil)
+
+	endOfMonth := model.PaymentTerm{ClosingDay: 31, PaymentMonthOffset: 1, PaymentDay: 31}
+	twentieth := model.PaymentTerm{ClosingDay: 20, PaymentMonthOffset: 1, PaymentDay: 10, Adjustment: model.RollPreceding}
+
+	tests := []struct {
+		name        string
+		term        model.PaymentTerm
+		transaction time.Time
+		expected    model.PaymentDates
+	}{
+		{
+			name:        "月末締め翌月末払いは調整しなければ休日のまま",
+			term:        endOfMonth,
+			transaction: timex.Date(2025, 4, 15),
+			expected: model.PaymentDates{
+				Transaction:       timex.Date(2025, 4, 15),
+				Closing:           timex.Date(2025, 4, 30),
+				UnadjustedPayment: timex.Date(2025, 5, 31),
+				Payment:           timex.Date(2025, 5, 31),
+			},
+		},
+		{
+			name:        "月末締めの支払日は短い月の末日になる",
+			term:        endOfMonth,
+			transaction: timex.Date(2025, 1, 31),
+			expected: model.PaymentDates{
+				Transaction:       timex.Date(2025, 1, 31),
+				Closing:           timex.Date(2025, 1, 31),
+				UnadjustedPayment: timex.Date(2025, 2, 28),
+				Payment:           timex.Date(2025, 2, 28),
+			},
+		},
+		{
+			name:        "締め日当日の取引はその月に締められ、休日の支払日は前営業日になる",
+			term:        twentieth,
+			transaction: timex.Date(2025, 4, 20),
+			expected: model.PaymentDates{
+				Transaction:       timex.Date(2025, 4, 20),
+				Closing:           timex.Date(2025, 4, 20),
+				UnadjustedPayment: timex.Date(2025, 5, 10),
+				Payment:           timex.Date(2025, 5, 9),
+			},
+		},
+		{
+			name:        "締め日を過ぎた取引は翌月に締められる",
+			term:        twentieth,
+			transaction: timex.Date(2025, 4, 21),
+			expected: model.PaymentDates{
+				Transaction:       timex.Date(2025, 4, 21),
+				Closing:           timex.Date(2025, 5, 20),
+				UnadjustedPayment: timex.Date(2025, 6, 10),
+				Payment:           timex.Date(2025, 6, 10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.term.Dates(tt.transaction, calendar)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestPaymentTerm_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		term  model.PaymentTerm
+		valid bool
+	}{
+		{name: "20日締め当月25日払い", term: model.PaymentTerm{ClosingDay: 20, PaymentDay: 25}, valid: true},
+		{name: "当月の締め日より前の支払日", term: model.PaymentTerm{ClosingDay: 20, PaymentDay: 10}},
+		{name: "範囲外の締め日", term: model.PaymentTerm{ClosingDay: 0, PaymentMonthOffset: 1, PaymentDay: 10}},
+		{name: "未知の調整方法", term: model.PaymentTerm{ClosingDay: 31, PaymentMonthOffset: 1, PaymentDay: 31, Adjustment: "nearest"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.term.Validate()
+
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// RollConvention is a business-day adjustment convention that moves a date falling on a non-business day
+type RollConvention string
+
+const (
+	RollFollowing         RollConvention = "following"          // The next business day
+	RollModifiedFollowing RollConvention = "modified_following" // The next business day, unless it is in the next month, then the previous business day
+	RollPreceding         RollConvention = "preceding"          // The previous business day
+	RollModifiedPreceding RollConvention = "modified_preceding" // The previous business day, unless it is in the previous month, then the next business day
+	RollEndOfMonth        RollConvention = "end_of_month"       // The last business day of the month, whatever the date
+)
+
+// ParseRollConvention parses a roll convention such as "modified_following"
+func ParseRollConvention(s string) (RollConvention, error) {
+	switch c := RollConvention(s); c {
+	case RollFollowing, RollModifiedFollowing, RollPreceding, RollModifiedPreceding, RollEndOfMonth:
+		return c, nil
+	default:
+		return "", xerrors.Errorf("invalid roll convention: %s", s)
+	}
+}
+
+// Roll adjusts the date to a business day of the calendar by the convention.
+// Business days are left as they are, except under RollEndOfMonth.
+// ErrOutOfPeriod is returned when the adjusted date would be outside the period of the calendar.
+func Roll(date time.Time, convention RollConvention, calendar *BusinessCalendar) (time.Time, error) {
+	date = timex.DateOf(date)
+	if !calendar.Contains(date) {
+		return time.Time{}, ErrOutOfPeriod
+	}
+
+	switch convention {
+	case RollFollowing:
+		return nextBusinessDay(date, 1, calendar)
+	case RollPreceding:
+		return nextBusinessDay(date, -1, calendar)
+	case RollModifiedFollowing:
+		rolled, err := nextBusinessDay(date, 1, calendar)
+		if err != nil || rolled.Month() == date.Month() {
+			return rolled, err
+		}
+		return nextBusinessDay(date, -1, calendar)
+	case RollModifiedPreceding:
+		rolled, err := nextBusinessDay(date, -1, calendar)
+		if err != nil || rolled.Month() == date.Month() {
+			return rolled, err
+		}
+		return nextBusinessDay(date, 1, calendar)
+	case RollEndOfMonth:
+		return nextBusinessDay(lastDayOfMonth(date.Year(), date.Month()), -1, calendar)
+	default:
+		return time.Time{}, xerrors.Errorf("invalid roll convention: %s", convention)
+	}
+}
+
+// nextBusinessDay returns the date itself when it is a business day, or else the closest business day in the direction
+func nextBusinessDay(date time.Time, direction int, calendar *BusinessCalendar) (time.Time, error) {
+	for d := date; calendar.Contains(d); d = d.AddDate(0, 0, direction) {
+		if calendar.IsBusinessDay(d) {
+			return d, nil
+		}
+	}
+	return time.Time{}, ErrOutOfPeriod
+}
+
+func lastDayOfMonth(year int, month time.Month) time.Time {
+	return timex.Date(year, month+1, 0)
+}
+
+// ScheduledDate is a date of a schedule before and after adjustment
+type ScheduledDate struct {
+	Unadjusted time.Time
+	Adjusted   time.Time
+}
+
+// MonthlySchedule is a date recurring every month, such as salary paid on the 25th, adjusted to a business day
+type MonthlySchedule struct {
+	Day        int // Day of the month from 1 to 31, the last day of the month for months shorter than that
+	Convention RollConvention
+}
+
+// Dates returns the dates of the schedule whose unadjusted dates are from begin to end inclusive.
+// The calendar must cover the months of the period and the business days the dates roll to.
+func (s MonthlySchedule) Dates(begin, end time.Time, calendar *BusinessCalendar) ([]ScheduledDate, error) {
+	if s.Day < 1 || s.Day > 31 {
+		return nil, xerrors.Errorf("invalid day of month: %d", s.Day)
+	}
+
+	begin, end = timex.DateOf(begin), timex.DateOf(end)
+	var dates []ScheduledDate
+	for month := timex.Date(begin.Year(), begin.Month(), 1); !month.After(end); month = month.AddDate(0, 1, 0) {
+		date := dayOfMonth(month.Year(), month.Month(), s.Day)
+		if date.Before(begin) || date.After(end) {
+			continue
+		}
+
+		adjusted, err := Roll(date, s.Convention, calendar)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, ScheduledDate{Unadjusted: date, Adjusted: adjusted})
+	}
+	return dates, nil
+}
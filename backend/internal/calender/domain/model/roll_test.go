@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestRoll(t *testing.T) {
+	// 2025-03-01, 2025-05-31 and 2025-06-28 are Saturdays, and 2025-05-03 to 2025-05-06 are national holidays
+	period := timex.TimeRange{Begin: timex.Date(2025, 2, 1), End: timex.Date(2025, 6, 29)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{
+			{Date: timex.Date(2025, 5, 3), Summary: "憲法記念日"},
+			{Date: timex.Date(2025, 5, 4), Summary: "みどりの日"},
+			{Date: timex.Date(2025, 5, 5), Summary: "こどもの日"},
+			{Date: timex.Date(2025, 5, 6), Summary: "休日"},
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	tests := []struct {
+		name       string
+		date       time.Time
+		convention model.RollConvention
+		expected   time.Time
+	}{
+		{name: "営業日はそのまま", date: timex.Date(2025, 5, 2), convention: model.RollFollowing, expected: timex.Date(2025, 5, 2)},
+		{name: "翌営業日に調整される", date: timex.Date(2025, 5, 3), convention: model.RollFollowing, expected: timex.Date(2025, 5, 7)},
+		{name: "前営業日に調整される", date: timex.Date(2025, 5, 6), convention: model.RollPreceding, expected: timex.Date(2025, 5, 2)},
+		{name: "翌営業日が翌月になる場合は前営業日に調整される", date: timex.Date(2025, 5, 31), convention: model.RollModifiedFollowing, expected: timex.Date(2025, 5, 30)},
+		{name: "翌営業日が同じ月なら翌営業日に調整される", date: timex.Date(2025, 5, 4), convention: model.RollModifiedFollowing, expected: timex.Date(2025, 5, 7)},
+		{name: "前営業日が前月になる場合は翌営業日に調整される", date: timex.Date(2025, 3, 1), convention: model.RollModifiedPreceding, expected: timex.Date(2025, 3, 3)},
+		{name: "月末営業日に調整される", date: timex.Date(2025, 5, 10), convention: model.RollEndOfMonth, expected: timex.Date(2025, 5, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := model.Roll(tt.date, tt.convention, calendar)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("期間外に調整される場合はエラーになる", func(t *testing.T) {
+		_, err := model.Roll(timex.Date(2025, 6, 28), model.RollFollowing, calendar)
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+}
+
+func TestMonthlySchedule_Dates(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 6, 30)}
+	calendar := model.NewBusinessCalendar(period, nil, nil, nil, nil)
+
+	t.Run("毎月25日払いは翌営業日に調整される", func(t *testing.T) {
+		schedule := model.MonthlySchedule{Day: 25, Convention: model.RollFollowing}
+
+		actual, err := schedule.Dates(timex.Date(2025, 4, 1), timex.Date(2025, 6, 10), calendar)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ScheduledDate{
+			{Unadjusted: timex.Date(2025, 4, 25), Adjusted: timex.Date(2025, 4, 25)},
+			{Unadjusted: timex.Date(2025, 5, 25), Adjusted: timex.Date(2025, 5, 26)},
+		}, actual)
+	})
+
+	t.Run("月の日数より大きい日は月末日になる", func(t *testing.T) {
+		schedule := model.MonthlySchedule{Day: 31, Convention: model.RollModifiedFollowing}
+
+		actual, err := schedule.Dates(timex.Date(2025, 2, 1), timex.Date(2025, 3, 31), calendar)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ScheduledDate{
+			{Unadjusted: timex.Date(2025, 2, 28), Adjusted: timex.Date(2025, 2, 28)},
+			{Unadjusted: timex.Date(2025, 3, 31), Adjusted: timex.Date(2025, 3, 31)},
+		}, actual)
+	})
+
+	t.Run("範囲外の日はエラーになる", func(t *testing.T) {
+		_, err := model.MonthlySchedule{Day: 32, Convention: model.RollFollowing}.Dates(period.Begin, period.End, calendar)
+
+		assert.Error(t, err)
+	})
+}
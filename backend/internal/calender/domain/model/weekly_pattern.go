@@ -0,0 +1,65 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Weekdays is a set of days of the week, bit 0 being Sunday and bit 6 Saturday
+type Weekdays uint8
+
+// DefaultWorkingDays are the working days of a calendar without a weekly pattern, Monday to Friday
+const DefaultWorkingDays = Weekdays(1<<time.Monday | 1<<time.Tuesday | 1<<time.Wednesday | 1<<time.Thursday | 1<<time.Friday)
+
+// NewWeekdays creates a set of the given days of the week
+func NewWeekdays(days ...time.Weekday) Weekdays {
+	var w Weekdays
+	for _, d := range days {
+		w |= 1 << d
+	}
+	return w
+}
+
+// Contains reports whether the day of the week is in the set
+func (w Weekdays) Contains(d time.Weekday) bool {
+	return w&(1<<d) != 0
+}
+
+// String returns the days in the set from Sunday, e.g. "Monday,Tuesday"
+func (w Weekdays) String() string {
+	var days []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if w.Contains(d) {
+			days = append(days, d.String())
+		}
+	}
+	return strings.Join(days, ",")
+}
+
+// WeeklyPattern defines the working days of the week of a calendar from a date onwards
+type WeeklyPattern struct {
+	EffectiveFrom time.Time
+	WorkingDays   Weekdays
+}
+
+// weeklyPatterns answers which pattern is in effect on a date
+type weeklyPatterns []WeeklyPattern
+
+// newWeeklyPatterns sorts the patterns by the date they take effect
+func newWeeklyPatterns(patterns []WeeklyPattern) weeklyPatterns {
+	sorted := make(weeklyPatterns, len(patterns))
+	copy(sorted, patterns)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].EffectiveFrom.Before(sorted[j].EffectiveFrom) })
+	return sorted
+}
+
+// workingDays returns the working days of the latest pattern in effect on the date,
+// or DefaultWorkingDays before the first pattern
+func (p weeklyPatterns) workingDays(date time.Time) Weekdays {
+	i := sort.Search(len(p), func(i int) bool { return p[i].EffectiveFrom.After(date) })
+	if i == 0 {
+		return DefaultWorkingDays
+	}
+	return p[i-1].WorkingDays
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"math/bits"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// YearBusinessDays is a bitset of the business days of a calendar in a year, one bit per day of the year.
+// It answers business-day checks without the holidays and closed days behind them, and is safe for concurrent readers.
+type YearBusinessDays struct {
+	year int
+	bits [6]uint64 // Bit i is set when day i+1 of the year is a business day
+}
+
+// NewYearBusinessDays builds the bitset of the year from the calendar, which must cover the whole year
+func NewYearBusinessDays(calendar *BusinessCalendar, year int) (*YearBusinessDays, error) {
+	first, last := timex.Date(year, time.January, 1), timex.Date(year, time.December, 31)
+	if !calendar.Contains(first) || !calendar.Contains(last) {
+		return nil, ErrOutOfPeriod
+	}
+
+	y := &YearBusinessDays{year: year}
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if calendar.IsBusinessDay(d) {
+			i := d.YearDay() - 1
+			y.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+	return y, nil
+}
+
+// Year returns the year of the bitset
+func (y *YearBusinessDays) Year() int {
+	return y.year
+}
+
+// IsBusinessDay reports whether the date is a business day. ErrOutOfPeriod is returned for dates of other years.
+func (y *YearBusinessDays) IsBusinessDay(date time.Time) (bool, error) {
+	date = timex.DateOf(date)
+	if date.Year() != y.year {
+		return false, ErrOutOfPeriod
+	}
+	i := date.YearDay() - 1
+	return y.bits[i/64]&(1<<(i%64)) != 0, nil
+}
+
+// Count returns the number of business days in the year
+func (y *YearBusinessDays) Count() int {
+	var n int
+	for _, b := range y.bits {
+		n += bits.OnesCount64(b)
+	}
+	return n
+}
@@ -0,0 +1,48 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestYearBusinessDays(t *testing.T) {
+	// 2024 is a leap year with 262 weekdays
+	period := timex.TimeRange{Begin: timex.Date(2024, 1, 1), End: timex.Date(2024, 12, 31)}
+	calendar := model.NewBusinessCalendar(
+		period,
+		[]model.NationalHoliday{{Date: timex.Date(2024, 1, 1), Summary: "元日"}},
+		[]model.ClosedDay{{Date: timex.Date(2024, 12, 31), Summary: "年末年始休業"}},
+		nil,
+		nil,
+	)
+	year, err := model.NewYearBusinessDays(calendar, 2024)
+	assert.NoError(t, err)
+
+	t.Run("カレンダーと同じ営業日になる", func(t *testing.T) {
+		for d := period.Begin; !d.After(period.End); d = d.AddDate(0, 0, 1) {
+			actual, err := year.IsBusinessDay(d)
+
+			assert.NoError(t, err)
+			assert.Equal(t, calendar.IsBusinessDay(d), actual, d.Format("2006-01-02"))
+		}
+	})
+
+	t.Run("年間の営業日数が数えられる", func(t *testing.T) {
+		assert.Equal(t, 260, year.Count())
+	})
+
+	t.Run("別の年の日付はエラーになる", func(t *testing.T) {
+		_, err := year.IsBusinessDay(timex.Date(2025, 1, 1))
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+
+	t.Run("年の途中までのカレンダーからは作れない", func(t *testing.T) {
+		_, err := model.NewYearBusinessDays(calendar, 2025)
+
+		assert.ErrorIs(t, err, model.ErrOutOfPeriod)
+	})
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// BusinessCalendarRepository loads the holidays and closed days that make up a business calendar
+type BusinessCalendarRepository interface {
+	// FindByPeriod loads the calendar for every date of the period,
+	// returning model.ErrCalendarNotFound when the calendar does not exist
+	FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessCalendar, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// BusinessDayIndexRepository provides the business-day ordinal index of each calendar
+type BusinessDayIndexRepository interface {
+	// FindByPeriod returns an index of the calendar covering at least every date of the period,
+	// returning model.ErrCalendarNotFound when the calendar does not exist
+	FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessDayIndex, error)
+
+	// Invalidate discards every index, so that they are rebuilt from the current holidays and closed days
+	Invalidate()
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// BusinessDaySetRepository provides the business days of each calendar as a bitset per year
+type BusinessDaySetRepository interface {
+	// FindByYear returns the business days of the calendar in the year,
+	// returning model.ErrCalendarNotFound when the calendar does not exist
+	FindByYear(ctx context.Context, calendarID string, year int) (*model.YearBusinessDays, error)
+
+	// Invalidate discards every bitset, so that they are rebuilt from the current holidays and closed days
+	Invalidate()
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// BusinessHoursRepository loads the opening hours of a calendar
+type BusinessHoursRepository interface {
+	// FindByPeriod loads the opening hours of the calendar with the date exceptions of the period,
+	// returning model.ErrCalendarNotFound when the calendar does not exist
+	FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessHours, error)
+}
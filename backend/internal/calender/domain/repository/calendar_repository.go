@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// CalendarRepository loads the calendars of the company
+type CalendarRepository interface {
+	// FindAll loads every calendar in identifier order
+	FindAll(ctx context.Context) ([]model.Calendar, error)
+
+	// FindByID loads a calendar, returning model.ErrCalendarNotFound when it does not exist
+	FindByID(ctx context.Context, id string) (*model.Calendar, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// ClosedDayRepository stores the closed days of each calendar as closures of consecutive days
+type ClosedDayRepository interface {
+	// Save inserts the closures of the calendar. Dates that are already closed are taken over by the new closures,
+	// shortening or splitting the closures they belonged to.
+	// model.ErrCalendarNotFound is returned when the calendar does not exist.
+	Save(ctx context.Context, calendarID string, closures []model.Closure) error
+}
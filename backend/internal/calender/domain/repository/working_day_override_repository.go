@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// WorkingDayOverrideRepository stores the dates each calendar works on despite holidays, weekends and closures
+type WorkingDayOverrideRepository interface {
+	// Save inserts the overrides of the calendar, replacing the summary of dates that are already overridden.
+	// model.ErrCalendarNotFound is returned when the calendar does not exist.
+	Save(ctx context.Context, calendarID string, overrides []model.WorkingDayOverride) error
+}
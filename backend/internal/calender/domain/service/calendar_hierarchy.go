@@ -0,0 +1,147 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// CalendarHierarchy resolves calendars that inherit the closed days of a parent calendar
+type CalendarHierarchy struct {
+	calendars map[string]model.Calendar
+}
+
+// NewCalendarHierarchy creates a CalendarHierarchy of the given calendars
+func NewCalendarHierarchy(calendars []model.Calendar) *CalendarHierarchy {
+	h := &CalendarHierarchy{calendars: make(map[string]model.Calendar, len(calendars))}
+	for _, c := range calendars {
+		h.calendars[c.ID] = c
+	}
+	return h
+}
+
+// Chain returns the calendar and its ancestors, starting from the calendar without a parent.
+// model.ErrCalendarNotFound is returned when the calendar or one of its ancestors does not exist,
+// and model.ErrCalendarCycle when the calendar is its own ancestor.
+func (h *CalendarHierarchy) Chain(id string) ([]model.Calendar, error) {
+	var chain []model.Calendar
+	visited := map[string]bool{}
+	for next := id; next != ""; {
+		if visited[next] {
+			return nil, xerrors.Errorf("%s: %w", id, model.ErrCalendarCycle)
+		}
+		visited[next] = true
+
+		c, ok := h.calendars[next]
+		if !ok {
+			return nil, xerrors.Errorf("%s: %w", next, model.ErrCalendarNotFound)
+		}
+		chain = append(chain, c)
+		next = c.ParentID
+	}
+
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// ResolveDays returns the effective closed days and working-day overrides of the last layer within the period in date order.
+// Layers are applied from the root: each one first applies its working-day overrides, which remove inherited closed days,
+// then adds the days of its rules and finally its own closed days, each replacing earlier closed days or overrides
+// on the same date. nationalHolidays must cover model.ClosedDayRulePeriod(period).
+func ResolveDays(layers []model.CalendarLayer, period timex.TimeRange, nationalHolidays []model.NationalHoliday) ([]model.ClosedDay, []model.WorkingDayOverride, error) {
+	closedDays := map[string]model.ClosedDay{}
+	overrides := map[string]model.WorkingDayOverride{}
+	for _, layer := range layers {
+		for _, o := range layer.WorkingDayOverrides {
+			key := o.Date.Format(time.DateOnly)
+			delete(closedDays, key)
+			overrides[key] = o
+		}
+
+		var added []model.ClosedDay
+		for _, rule := range layer.ClosedDayRules {
+			expanded, err := rule.Expand(period, nationalHolidays)
+			if err != nil {
+				return nil, nil, xerrors.Errorf("failed to expand rules of %s: %w", layer.Calendar.ID, err)
+			}
+			added = append(added, expanded...)
+		}
+		added = append(added, layer.ClosedDays...)
+		for _, d := range added {
+			key := d.Date.Format(time.DateOnly)
+			delete(overrides, key)
+			closedDays[key] = d
+		}
+	}
+
+	resolvedClosedDays := make([]model.ClosedDay, 0, len(closedDays))
+	for _, d := range closedDays {
+		resolvedClosedDays = append(resolvedClosedDays, d)
+	}
+	sort.Slice(resolvedClosedDays, func(i, j int) bool { return resolvedClosedDays[i].Date.Before(resolvedClosedDays[j].Date) })
+
+	resolvedOverrides := make([]model.WorkingDayOverride, 0, len(overrides))
+	for _, o := range overrides {
+		resolvedOverrides = append(resolvedOverrides, o)
+	}
+	sort.Slice(resolvedOverrides, func(i, j int) bool { return resolvedOverrides[i].Date.Before(resolvedOverrides[j].Date) })
+
+	return resolvedClosedDays, resolvedOverrides, nil
+}
+
+// ResolveWeeklyPatterns returns the weekly patterns of the last layer that has any.
+// A calendar without weekly patterns follows the patterns of its nearest ancestor.
+func ResolveWeeklyPatterns(layers []model.CalendarLayer) []model.WeeklyPattern {
+	for i := len(layers) - 1; i >= 0; i-- {
+		if len(layers[i].WeeklyPatterns) > 0 {
+			return layers[i].WeeklyPatterns
+		}
+	}
+	return nil
+}
+
+// ResolveBusinessHours returns the business hours of the last layer in the time zone of its calendar.
+// Weekday hours come from the last layer that has any, while date hours of later layers replace those of
+// earlier layers on the same date.
+func ResolveBusinessHours(layers []model.CalendarLayer) (*model.BusinessHours, error) {
+	timeZone := model.DefaultTimeZone
+	if len(layers) > 0 && layers[len(layers)-1].Calendar.TimeZone != "" {
+		timeZone = layers[len(layers)-1].Calendar.TimeZone
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid time zone %s: %w", timeZone, err)
+	}
+
+	var weekly []model.WeekdayHours
+	for i := len(layers) - 1; i >= 0; i-- {
+		if len(layers[i].WeekdayHours) > 0 {
+			weekly = layers[i].WeekdayHours
+			break
+		}
+	}
+
+	byDate := map[string][]model.DateHours{}
+	for _, layer := range layers {
+		replaced := map[string]bool{}
+		for _, h := range layer.DateHours {
+			key := h.Date.Format(time.DateOnly)
+			if !replaced[key] {
+				replaced[key] = true
+				byDate[key] = nil
+			}
+			byDate[key] = append(byDate[key], h)
+		}
+	}
+
+	var exceptions []model.DateHours
+	for _, hours := range byDate {
+		exceptions = append(exceptions, hours...)
+	}
+	return model.NewBusinessHours(location, weekly, exceptions), nil
+}
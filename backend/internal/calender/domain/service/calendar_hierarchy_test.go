@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/service"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+func TestCalendarHierarchy_Chain(t *testing.T) {
+	hierarchy := service.NewCalendarHierarchy([]model.Calendar{
+		{ID: "default", Name: "本社"},
+		{ID: "kyushu", Name: "九州支社", ParentID: "default"},
+		{ID: "okinawa", Name: "沖縄営業所", ParentID: "kyushu"},
+		{ID: "orphan", Name: "孤立", ParentID: "missing"},
+		{ID: "a", Name: "A", ParentID: "b"},
+		{ID: "b", Name: "B", ParentID: "a"},
+	})
+
+	t.Run("祖先から順に返される", func(t *testing.T) {
+		actual, err := hierarchy.Chain("okinawa")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.Calendar{
+			{ID: "default", Name: "本社"},
+			{ID: "kyushu", Name: "九州支社", ParentID: "default"},
+			{ID: "okinawa", Name: "沖縄営業所", ParentID: "kyushu"},
+		}, actual)
+	})
+
+	t.Run("存在しないカレンダーはエラーになる", func(t *testing.T) {
+		_, err := hierarchy.Chain("unknown")
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+
+	t.Run("存在しない親カレンダーはエラーになる", func(t *testing.T) {
+		_, err := hierarchy.Chain("orphan")
+
+		assert.ErrorIs(t, err, model.ErrCalendarNotFound)
+	})
+
+	t.Run("循環する継承はエラーになる", func(t *testing.T) {
+		_, err := hierarchy.Chain("a")
+
+		assert.ErrorIs(t, err, model.ErrCalendarCycle)
+	})
+}
+
+func TestResolveDays(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 12, 31)}
+	layers := []model.CalendarLayer{
+		{
+			Calendar: model.Calendar{ID: "default"},
+			ClosedDays: []model.ClosedDay{
+				{Date: timex.Date(2025, 8, 13), Summary: "夏季休業"},
+				{Date: timex.Date(2025, 8, 14), Summary: "夏季休業"},
+				{Date: timex.Date(2025, 8, 15), Summary: "夏季休業"},
+			},
+		},
+		{
+			Calendar: model.Calendar{ID: "kyushu", ParentID: "default"},
+			WorkingDayOverrides: []model.WorkingDayOverride{
+				{Date: timex.Date(2025, 8, 15), Summary: "営業日"},
+			},
+		},
+		{
+			Calendar: model.Calendar{ID: "okinawa", ParentID: "kyushu"},
+			ClosedDays: []model.ClosedDay{
+				{Date: timex.Date(2025, 6, 23), Summary: "慰霊の日"},
+				{Date: timex.Date(2025, 8, 14), Summary: "旧盆"},
+			},
+			WorkingDayOverrides: []model.WorkingDayOverride{
+				{Date: timex.Date(2025, 8, 13), Summary: "営業日"},
+			},
+		},
+	}
+
+	t.Run("親の休業日に追加と削除が重ねられる", func(t *testing.T) {
+		actual, _, err := service.ResolveDays(layers, period, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 6, 23), Summary: "慰霊の日"},
+			{Date: timex.Date(2025, 8, 14), Summary: "旧盆"},
+		}, actual)
+	})
+
+	t.Run("中間のカレンダーでは子の変更が反映されない", func(t *testing.T) {
+		actual, _, err := service.ResolveDays(layers[:2], period, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 8, 13), Summary: "夏季休業"},
+			{Date: timex.Date(2025, 8, 14), Summary: "夏季休業"},
+		}, actual)
+	})
+
+	t.Run("規則による休業日にも上書きが適用される", func(t *testing.T) {
+		actual, _, err := service.ResolveDays([]model.CalendarLayer{
+			{
+				Calendar: model.Calendar{ID: "default"},
+				ClosedDayRules: []model.ClosedDayRule{
+					{Summary: "隔週土曜休業", StartsOn: timex.Date(2025, 1, 11), RRule: "FREQ=MONTHLY;BYDAY=2SA,4SA", DurationDays: 1},
+				},
+				ClosedDays: []model.ClosedDay{{Date: timex.Date(2025, 1, 25), Summary: "棚卸"}},
+			},
+			{
+				Calendar:            model.Calendar{ID: "kyushu", ParentID: "default"},
+				WorkingDayOverrides: []model.WorkingDayOverride{{Date: timex.Date(2025, 2, 8), Summary: "営業日"}},
+			},
+		}, timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 2, 28)}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{
+			{Date: timex.Date(2025, 1, 11), Summary: "隔週土曜休業"},
+			{Date: timex.Date(2025, 1, 25), Summary: "棚卸"},
+			{Date: timex.Date(2025, 2, 22), Summary: "隔週土曜休業"},
+		}, actual)
+	})
+}
+
+func TestResolveDays_WorkingDayOverrides(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 1, 1), End: timex.Date(2025, 12, 31)}
+	layers := []model.CalendarLayer{
+		{
+			Calendar:            model.Calendar{ID: "default"},
+			WorkingDayOverrides: []model.WorkingDayOverride{{Date: timex.Date(2025, 4, 29), Summary: "棚卸"}},
+		},
+		{
+			Calendar:   model.Calendar{ID: "okinawa", ParentID: "default"},
+			ClosedDays: []model.ClosedDay{{Date: timex.Date(2025, 4, 29), Summary: "休業日"}},
+		},
+	}
+
+	t.Run("出勤日は子に継承される", func(t *testing.T) {
+		closedDays, overrides, err := service.ResolveDays(layers[:1], period, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, closedDays)
+		assert.Equal(t, []model.WorkingDayOverride{{Date: timex.Date(2025, 4, 29), Summary: "棚卸"}}, overrides)
+	})
+
+	t.Run("子の休業日は継承した出勤日を取り消す", func(t *testing.T) {
+		closedDays, overrides, err := service.ResolveDays(layers, period, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []model.ClosedDay{{Date: timex.Date(2025, 4, 29), Summary: "休業日"}}, closedDays)
+		assert.Empty(t, overrides)
+	})
+}
+
+func TestResolveWeeklyPatterns(t *testing.T) {
+	sixDays := []model.WeeklyPattern{{
+		EffectiveFrom: timex.Date(2025, 1, 1),
+		WorkingDays:   model.NewWeekdays(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday),
+	}}
+	layers := []model.CalendarLayer{
+		{Calendar: model.Calendar{ID: "default"}},
+		{Calendar: model.Calendar{ID: "factory", ParentID: "default"}, WeeklyPatterns: sixDays},
+		{Calendar: model.Calendar{ID: "line-a", ParentID: "factory"}},
+	}
+
+	t.Run("最も近い祖先のパターンが使われる", func(t *testing.T) {
+		assert.Equal(t, sixDays, service.ResolveWeeklyPatterns(layers))
+	})
+
+	t.Run("パターンがなければ空になる", func(t *testing.T) {
+		assert.Empty(t, service.ResolveWeeklyPatterns(layers[:1]))
+	})
+}
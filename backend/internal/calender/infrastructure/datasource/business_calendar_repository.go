@@ -0,0 +1,218 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/calender/domain/service"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type businessCalendarRepository struct {
+	q *query.Query
+}
+
+// NewBusinessCalendarRepository creates a BusinessCalendarRepository backed by the generated query package
+func NewBusinessCalendarRepository(q *query.Query) repository.BusinessCalendarRepository {
+	return &businessCalendarRepository{q: q}
+}
+
+func (r *businessCalendarRepository) FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessCalendar, error) {
+	calendars, err := findCalendars(ctx, r.q)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := service.NewCalendarHierarchy(calendars).Chain(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rules relative to national holidays need the holidays before the period as well
+	rulePeriod := model.ClosedDayRulePeriod(period)
+	nh := r.q.NationalHoliday
+	holidayRows, err := nh.WithContext(ctx).
+		Where(nh.Date.Between(rulePeriod.Begin, rulePeriod.End)).
+		Order(nh.Date).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find national holidays: %w", err)
+	}
+
+	layers, err := r.findLayers(ctx, chain, period)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleHolidays := make([]model.NationalHoliday, 0, len(holidayRows))
+	holidays := make([]model.NationalHoliday, 0, len(holidayRows))
+	for _, row := range holidayRows {
+		names, err := parseNames(row.Names)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid names of national holiday %s: %w", row.Date.Format(time.DateOnly), err)
+		}
+		h := model.NationalHoliday{
+			Date:     timex.DateOf(row.Date),
+			Summary:  row.Summary,
+			Category: model.HolidayCategory(row.Category),
+			Names:    names,
+		}
+		ruleHolidays = append(ruleHolidays, h)
+		if !h.Date.Before(timex.DateOf(period.Begin)) {
+			holidays = append(holidays, h)
+		}
+	}
+
+	closedDays, overrides, err := service.ResolveDays(layers, period, ruleHolidays)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewBusinessCalendar(period, holidays, closedDays, overrides, service.ResolveWeeklyPatterns(layers)), nil
+}
+
+// findLayers loads the closed days, closed-day rules, working-day overrides and weekly patterns
+// of every calendar of the chain, keeping the order of the chain
+func (r *businessCalendarRepository) findLayers(ctx context.Context, chain []model.Calendar, period timex.TimeRange) ([]model.CalendarLayer, error) {
+	ids := make([]string, 0, len(chain))
+	layers := make([]model.CalendarLayer, 0, len(chain))
+	index := make(map[string]int, len(chain))
+	for i, c := range chain {
+		ids = append(ids, c.ID)
+		layers = append(layers, model.CalendarLayer{Calendar: c})
+		index[c.ID] = i
+	}
+
+	cd := r.q.ClosedDay
+	closedDayRows, err := cd.WithContext(ctx).
+		Where(cd.CalendarID.In(ids...), overlaps(period)).
+		Order(cd.Period).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find closed days: %w", err)
+	}
+
+	o := r.q.WorkingDayOverride
+	overrideRows, err := o.WithContext(ctx).
+		Where(o.CalendarID.In(ids...), o.Date.Between(period.Begin, period.End)).
+		Order(o.Date).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find working day overrides: %w", err)
+	}
+
+	// Patterns take effect from a date, so the ones before the period are needed as well
+	wp := r.q.WeeklyPattern
+	patternRows, err := wp.WithContext(ctx).
+		Where(wp.CalendarID.In(ids...), wp.EffectiveFrom.Lte(period.End)).
+		Order(wp.EffectiveFrom).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find weekly patterns: %w", err)
+	}
+
+	rules, err := r.findRules(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// Closures are stored as date ranges, while calendars answer per date
+	for _, row := range closedDayRows {
+		closure, err := closureOf(row)
+		if err != nil {
+			return nil, err
+		}
+		layer := &layers[index[row.CalendarID]]
+		layer.ClosedDays = append(layer.ClosedDays, closure.Days(period)...)
+	}
+	for _, row := range overrideRows {
+		layer := &layers[index[row.CalendarID]]
+		layer.WorkingDayOverrides = append(layer.WorkingDayOverrides, model.WorkingDayOverride{
+			Date:    timex.DateOf(row.Date),
+			Summary: row.Summary,
+		})
+	}
+
+	for _, row := range patternRows {
+		layer := &layers[index[row.CalendarID]]
+		layer.WeeklyPatterns = append(layer.WeeklyPatterns, model.WeeklyPattern{
+			EffectiveFrom: timex.DateOf(row.EffectiveFrom),
+			WorkingDays:   model.Weekdays(row.WorkingWeekdays),
+		})
+	}
+	for calendarID, calendarRules := range rules {
+		layers[index[calendarID]].ClosedDayRules = calendarRules
+	}
+
+	return layers, nil
+}
+
+// findRules loads the closed-day rules and their exceptions of the calendars, grouped by calendar id
+func (r *businessCalendarRepository) findRules(ctx context.Context, calendarIDs []string) (map[string][]model.ClosedDayRule, error) {
+	cr := r.q.ClosedDayRule
+	ruleRows, err := cr.WithContext(ctx).
+		Where(cr.CalendarID.In(calendarIDs...)).
+		Order(cr.ID).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find closed day rules: %w", err)
+	}
+	if len(ruleRows) == 0 {
+		return nil, nil
+	}
+
+	ruleIDs := make([]int64, 0, len(ruleRows))
+	for _, row := range ruleRows {
+		ruleIDs = append(ruleIDs, row.ID)
+	}
+
+	e := r.q.ClosedDayRuleException
+	exceptionRows, err := e.WithContext(ctx).
+		Where(e.RuleID.In(ruleIDs...)).
+		Order(e.Date).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find closed day rule exceptions: %w", err)
+	}
+
+	exceptions := make(map[int64][]time.Time, len(ruleRows))
+	for _, row := range exceptionRows {
+		exceptions[row.RuleID] = append(exceptions[row.RuleID], timex.DateOf(row.Date))
+	}
+
+	rules := make(map[string][]model.ClosedDayRule, len(calendarIDs))
+	for _, row := range ruleRows {
+		names, err := parseNames(row.Names)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid names of closed day rule %d: %w", row.ID, err)
+		}
+		rules[row.CalendarID] = append(rules[row.CalendarID], model.ClosedDayRule{
+			ID:                   row.ID,
+			Summary:              row.Summary,
+			Category:             model.HolidayCategory(row.Category),
+			Names:                names,
+			StartsOn:             timex.DateOf(row.StartsOn),
+			RRule:                row.Rrule,
+			DurationDays:         int(row.DurationDays),
+			AfterNationalHoliday: row.AfterNationalHoliday,
+			Exceptions:           exceptions[row.ID],
+		})
+	}
+	return rules, nil
+}
+
+// parseNames decodes a names jsonb column, an object of names by language
+func parseNames(s string) (model.LocalizedNames, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var names model.LocalizedNames
+	if err := json.Unmarshal([]byte(s), &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
@@ -0,0 +1,315 @@
+package datasource_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/service"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// businessDayFixture is shared by the Go implementation and the SQL functions of the migrations,
+// so that both are checked against the same expectations
+type businessDayFixture struct {
+	Period struct {
+		Begin string `json:"begin"`
+		End   string `json:"end"`
+	} `json:"period"`
+	NationalHolidays []struct {
+		Date    string `json:"date"`
+		Summary string `json:"summary"`
+	} `json:"nationalHolidays"`
+	Calendars []struct {
+		ID             string `json:"id"`
+		Name           string `json:"name"`
+		ParentID       string `json:"parentId"`
+		WeeklyPatterns []struct {
+			EffectiveFrom   string `json:"effectiveFrom"`
+			WorkingWeekdays int    `json:"workingWeekdays"`
+		} `json:"weeklyPatterns"`
+		Closures []struct {
+			StartsOn string `json:"startsOn"`
+			EndsOn   string `json:"endsOn"`
+			Summary  string `json:"summary"`
+			OpensAt  string `json:"opensAt"`
+			ClosesAt string `json:"closesAt"`
+		} `json:"closures"`
+		ClosedDayRules []struct {
+			Summary              string   `json:"summary"`
+			StartsOn             string   `json:"startsOn"`
+			RRule                string   `json:"rrule"`
+			DurationDays         int      `json:"durationDays"`
+			AfterNationalHoliday bool     `json:"afterNationalHoliday"`
+			Exceptions           []string `json:"exceptions"`
+		} `json:"closedDayRules"`
+		WorkingDayOverrides []struct {
+			Date    string `json:"date"`
+			Summary string `json:"summary"`
+		} `json:"workingDayOverrides"`
+	} `json:"calendars"`
+	IsBusinessDay []struct {
+		Calendar string `json:"calendar"`
+		Date     string `json:"date"`
+		Expected bool   `json:"expected"`
+	} `json:"isBusinessDay"`
+	AddBusinessDays []struct {
+		Calendar string `json:"calendar"`
+		Date     string `json:"date"`
+		N        int    `json:"n"`
+		Expected string `json:"expected"`
+	} `json:"addBusinessDays"`
+	BusinessDaysBetween []struct {
+		Calendar string `json:"calendar"`
+		From     string `json:"from"`
+		To       string `json:"to"`
+		Expected int    `json:"expected"`
+	} `json:"businessDaysBetween"`
+	HolidayCategories []struct {
+		Date     string `json:"date"`
+		Expected string `json:"expected"`
+	} `json:"holidayCategories"`
+}
+
+func loadBusinessDayFixture(t *testing.T) businessDayFixture {
+	t.Helper()
+	b, err := os.ReadFile("testdata/business_day_functions.json")
+	require.NoError(t, err)
+
+	var f businessDayFixture
+	require.NoError(t, json.Unmarshal(b, &f))
+	return f
+}
+
+func fixtureDate(t *testing.T, s string) time.Time {
+	t.Helper()
+	d, err := time.ParseInLocation(time.DateOnly, s, timex.JST)
+	require.NoError(t, err)
+	return d
+}
+
+func fixtureTimeOfDay(t *testing.T, s string) time.Duration {
+	t.Helper()
+	if s == "" {
+		return 0
+	}
+	tod, err := time.Parse("15:04", s)
+	require.NoError(t, err)
+	return time.Duration(tod.Hour())*time.Hour + time.Duration(tod.Minute())*time.Minute
+}
+
+// businessCalendars builds the calendars of the fixture the same way the repository does
+func (f businessDayFixture) businessCalendars(t *testing.T) map[string]*model.BusinessCalendar {
+	t.Helper()
+	period := timex.TimeRange{Begin: fixtureDate(t, f.Period.Begin), End: fixtureDate(t, f.Period.End)}
+
+	var ruleHolidays, holidays []model.NationalHoliday
+	for _, h := range f.NationalHolidays {
+		holiday := model.NationalHoliday{Date: fixtureDate(t, h.Date), Summary: h.Summary}
+		ruleHolidays = append(ruleHolidays, holiday)
+		if !holiday.Date.Before(period.Begin) {
+			holidays = append(holidays, holiday)
+		}
+	}
+
+	var calendars []model.Calendar
+	layers := map[string]model.CalendarLayer{}
+	for _, c := range f.Calendars {
+		calendar := model.Calendar{ID: c.ID, Name: c.Name, ParentID: c.ParentID}
+		calendars = append(calendars, calendar)
+
+		layer := model.CalendarLayer{Calendar: calendar}
+		for _, p := range c.WeeklyPatterns {
+			layer.WeeklyPatterns = append(layer.WeeklyPatterns, model.WeeklyPattern{
+				EffectiveFrom: fixtureDate(t, p.EffectiveFrom),
+				WorkingDays:   model.Weekdays(p.WorkingWeekdays),
+			})
+		}
+		for _, d := range c.Closures {
+			closure := model.Closure{
+				StartsOn: fixtureDate(t, d.StartsOn),
+				EndsOn:   fixtureDate(t, d.EndsOn),
+				Summary:  d.Summary,
+				OpensAt:  fixtureTimeOfDay(t, d.OpensAt),
+				ClosesAt: fixtureTimeOfDay(t, d.ClosesAt),
+			}
+			layer.ClosedDays = append(layer.ClosedDays, closure.Days(period)...)
+		}
+		for i, r := range c.ClosedDayRules {
+			rule := model.ClosedDayRule{
+				ID:                   int64(i + 1),
+				Summary:              r.Summary,
+				StartsOn:             fixtureDate(t, r.StartsOn),
+				RRule:                r.RRule,
+				DurationDays:         r.DurationDays,
+				AfterNationalHoliday: r.AfterNationalHoliday,
+			}
+			for _, e := range r.Exceptions {
+				rule.Exceptions = append(rule.Exceptions, fixtureDate(t, e))
+			}
+			layer.ClosedDayRules = append(layer.ClosedDayRules, rule)
+		}
+		for _, o := range c.WorkingDayOverrides {
+			layer.WorkingDayOverrides = append(layer.WorkingDayOverrides, model.WorkingDayOverride{
+				Date:    fixtureDate(t, o.Date),
+				Summary: o.Summary,
+			})
+		}
+		layers[c.ID] = layer
+	}
+
+	hierarchy := service.NewCalendarHierarchy(calendars)
+	result := make(map[string]*model.BusinessCalendar, len(calendars))
+	for _, c := range calendars {
+		chain, err := hierarchy.Chain(c.ID)
+		require.NoError(t, err)
+
+		chainLayers := make([]model.CalendarLayer, 0, len(chain))
+		for _, link := range chain {
+			chainLayers = append(chainLayers, layers[link.ID])
+		}
+		closedDays, overrides, err := service.ResolveDays(chainLayers, period, ruleHolidays)
+		require.NoError(t, err)
+
+		result[c.ID] = model.NewBusinessCalendar(period, holidays, closedDays, overrides, service.ResolveWeeklyPatterns(chainLayers))
+	}
+	return result
+}
+
+func TestBusinessDayFunctions_Model(t *testing.T) {
+	f := loadBusinessDayFixture(t)
+	calendars := f.businessCalendars(t)
+
+	for _, tt := range f.IsBusinessDay {
+		t.Run("営業日判定 "+tt.Calendar+" "+tt.Date, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, calendars[tt.Calendar].IsBusinessDay(fixtureDate(t, tt.Date)))
+		})
+	}
+
+	for _, tt := range f.AddBusinessDays {
+		t.Run("営業日加算 "+tt.Calendar+" "+tt.Date, func(t *testing.T) {
+			actual, err := model.NewBusinessDayIndex(calendars[tt.Calendar]).AddBusinessDays(fixtureDate(t, tt.Date), tt.N)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual.Format(time.DateOnly))
+		})
+	}
+
+	for _, tt := range f.BusinessDaysBetween {
+		t.Run("営業日数 "+tt.Calendar+" "+tt.From+" "+tt.To, func(t *testing.T) {
+			actual, err := model.NewBusinessDayIndex(calendars[tt.Calendar]).BusinessDaysBetween(fixtureDate(t, tt.From), fixtureDate(t, tt.To))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual)
+		})
+	}
+}
+
+// TestBusinessDayFunctions_Database checks the SQL functions against the fixture.
+// It needs a migrated database, given by BUSINESS_DB_TEST_DSN, and rolls back everything it inserts.
+// The test workflow runs it, also under -short, against a Postgres service migrated before the tests.
+func TestBusinessDayFunctions_Database(t *testing.T) {
+	dsn := os.Getenv("BUSINESS_DB_TEST_DSN")
+	if dsn == "" {
+		t.Skip("BUSINESS_DB_TEST_DSN is not set")
+	}
+
+	ctx := context.Background()
+	f := loadBusinessDayFixture(t)
+
+	conn, err := pgx.Connect(ctx, dsn)
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	tx, err := conn.Begin(ctx)
+	require.NoError(t, err)
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	insertBusinessDayFixture(t, ctx, tx, f)
+
+	for _, tt := range f.IsBusinessDay {
+		t.Run("営業日判定 "+tt.Calendar+" "+tt.Date, func(t *testing.T) {
+			var actual bool
+			err := tx.QueryRow(ctx, "select calender.is_business_day($1::date, $2)", tt.Date, tt.Calendar).Scan(&actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual)
+		})
+	}
+
+	for _, tt := range f.AddBusinessDays {
+		t.Run("営業日加算 "+tt.Calendar+" "+tt.Date, func(t *testing.T) {
+			var actual string
+			err := tx.QueryRow(ctx, "select calender.add_business_days($1::date, $2, $3)::text", tt.Date, tt.N, tt.Calendar).Scan(&actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual)
+		})
+	}
+
+	for _, tt := range f.BusinessDaysBetween {
+		t.Run("営業日数 "+tt.Calendar+" "+tt.From+" "+tt.To, func(t *testing.T) {
+			var actual int
+			err := tx.QueryRow(ctx, "select calender.business_days_between($1::date, $2::date, $3)", tt.From, tt.To, tt.Calendar).Scan(&actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual)
+		})
+	}
+
+	_, err = tx.Exec(ctx, "select calender.classify_national_holidays()")
+	require.NoError(t, err)
+	for _, tt := range f.HolidayCategories {
+		t.Run("祝日の種類 "+tt.Date, func(t *testing.T) {
+			var actual string
+			err := tx.QueryRow(ctx, "select category::text from calender.national_holiday where date = $1::date", tt.Date).Scan(&actual)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.Expected, actual)
+		})
+	}
+}
+
+func insertBusinessDayFixture(t *testing.T, ctx context.Context, tx pgx.Tx, f businessDayFixture) {
+	t.Helper()
+	exec := func(sql string, args ...any) {
+		t.Helper()
+		_, err := tx.Exec(ctx, sql, args...)
+		require.NoError(t, err)
+	}
+
+	// National holidays are shared by every calendar, so the ones of the fixture replace the stored ones
+	exec("delete from calender.national_holiday where date between $1::date - interval '1 year' and $2::date + interval '1 year'", f.Period.Begin, f.Period.End)
+	for _, h := range f.NationalHolidays {
+		exec("insert into calender.national_holiday (date, summary) values ($1::date, $2)", h.Date, h.Summary)
+	}
+
+	for _, c := range f.Calendars {
+		exec("insert into calender.calendars (id, name, parent_id) values ($1, $2, nullif($3, ''))", c.ID, c.Name, c.ParentID)
+		for _, p := range c.WeeklyPatterns {
+			exec("insert into calender.weekly_patterns (calendar_id, effective_from, working_weekdays) values ($1, $2::date, $3)", c.ID, p.EffectiveFrom, p.WorkingWeekdays)
+		}
+		for _, d := range c.Closures {
+			exec("insert into calender.closed_days (calendar_id, period, summary, opens_at, closes_at) values ($1, daterange($2::date, $3::date, '[]'), $4, nullif($5, '')::time, nullif($6, '')::time)",
+				c.ID, d.StartsOn, d.EndsOn, d.Summary, d.OpensAt, d.ClosesAt)
+		}
+		for _, r := range c.ClosedDayRules {
+			var id int64
+			err := tx.QueryRow(ctx, "insert into calender.closed_day_rules (calendar_id, summary, starts_on, rrule, duration_days, after_national_holiday) values ($1, $2, $3::date, $4, $5, $6) returning id",
+				c.ID, r.Summary, r.StartsOn, r.RRule, r.DurationDays, r.AfterNationalHoliday).Scan(&id)
+			require.NoError(t, err)
+			for _, e := range r.Exceptions {
+				exec("insert into calender.closed_day_rule_exceptions (rule_id, date) values ($1, $2::date)", id, e)
+			}
+		}
+		for _, o := range c.WorkingDayOverrides {
+			exec("insert into calender.working_day_overrides (calendar_id, date, summary) values ($1, $2::date, $3)", c.ID, o.Date, o.Summary)
+		}
+	}
+}
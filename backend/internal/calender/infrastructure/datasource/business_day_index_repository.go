@@ -0,0 +1,126 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+const (
+	// indexWindowYears is how many years before and after the current year an index covers at least
+	indexWindowYears = 5
+	// cacheTTL bounds how long a cached index or bitset is used, in case a change notification is missed
+	cacheTTL = 10 * time.Minute
+)
+
+type cachedIndex struct {
+	index   *model.BusinessDayIndex
+	builtAt time.Time
+}
+
+type businessDayIndexRepository struct {
+	calendars repository.BusinessCalendarRepository
+	now       func() time.Time
+	group     singleflight.Group
+
+	mu         sync.Mutex
+	indexes    map[string]cachedIndex // Indexes by calendar id
+	generation uint64                 // Incremented on invalidation, so that indexes built before it are not stored
+}
+
+// NewBusinessDayIndexRepository creates a BusinessDayIndexRepository that keeps the index of each calendar in memory.
+// Indexes are rebuilt when invalidated, when they expire and when a period outside them is requested,
+// and concurrent requests for the same index share a single build.
+func NewBusinessDayIndexRepository(calendars repository.BusinessCalendarRepository, caches *CacheRegistry) repository.BusinessDayIndexRepository {
+	r := &businessDayIndexRepository{
+		calendars: calendars,
+		now:       time.Now,
+		indexes:   map[string]cachedIndex{},
+	}
+	caches.Register(r)
+	return r
+}
+
+func (r *businessDayIndexRepository) FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessDayIndex, error) {
+	for {
+		now := r.now()
+
+		r.mu.Lock()
+		cached, ok := r.indexes[calendarID]
+		generation := r.generation
+		r.mu.Unlock()
+		if ok && cached.index.Covers(period) && now.Sub(cached.builtAt) < cacheTTL {
+			return cached.index, nil
+		}
+
+		// Build for the window around the current year, widened to the period only, so that the index does not
+		// keep growing with every period requested
+		window := union(timex.TimeRange{
+			Begin: timex.Date(now.Year()-indexWindowYears, time.January, 1),
+			End:   timex.Date(now.Year()+indexWindowYears, time.December, 31),
+		}, period)
+
+		// The build is shared by every caller waiting for it, so it must not be cancelled with the first one.
+		// Callers arriving after an invalidation do not join a build started before it.
+		buildCtx := context.WithoutCancel(ctx)
+		key := fmt.Sprintf("%s/%d/%s/%s", calendarID, generation, window.Begin.Format(time.DateOnly), window.End.Format(time.DateOnly))
+		v, err, _ := r.group.Do(key, func() (interface{}, error) {
+			// A build that finished after the cache was checked may already cover the period
+			r.mu.Lock()
+			cached, ok := r.indexes[calendarID]
+			r.mu.Unlock()
+			if ok && cached.index.Covers(period) && now.Sub(cached.builtAt) < cacheTTL {
+				return cached.index, nil
+			}
+
+			calendar, err := r.calendars.FindByPeriod(buildCtx, calendarID, window)
+			if err != nil {
+				return nil, err
+			}
+			index := model.NewBusinessDayIndex(calendar)
+
+			r.mu.Lock()
+			if r.generation == generation {
+				r.indexes[calendarID] = cachedIndex{index: index, builtAt: now}
+			}
+			r.mu.Unlock()
+			return index, nil
+		})
+		if err != nil {
+			return nil, xerrors.Errorf("failed to load calendar for index: %w", err)
+		}
+
+		// Build again when the caches were invalidated during the load, as the index may be stale
+		r.mu.Lock()
+		stale := r.generation != generation
+		r.mu.Unlock()
+		if stale {
+			continue
+		}
+		return v.(*model.BusinessDayIndex), nil
+	}
+}
+
+func (r *businessDayIndexRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.indexes = map[string]cachedIndex{}
+	r.generation++
+}
+
+func union(a, b timex.TimeRange) timex.TimeRange {
+	if b.Begin.Before(a.Begin) {
+		a.Begin = b.Begin
+	}
+	if b.End.After(a.End) {
+		a.End = b.End
+	}
+	return a
+}
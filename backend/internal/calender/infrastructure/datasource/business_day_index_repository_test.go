@@ -0,0 +1,94 @@
+package datasource_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// recordingBusinessCalendarRepository records the periods calendars are loaded for, holding every load until released
+type recordingBusinessCalendarRepository struct {
+	mu      sync.Mutex
+	periods []timex.TimeRange
+	release chan struct{}
+}
+
+func (r *recordingBusinessCalendarRepository) FindByPeriod(_ context.Context, _ string, period timex.TimeRange) (*model.BusinessCalendar, error) {
+	r.mu.Lock()
+	r.periods = append(r.periods, period)
+	r.mu.Unlock()
+
+	<-r.release
+	return model.NewBusinessCalendar(period, nil, nil, nil, nil), nil
+}
+
+func (r *recordingBusinessCalendarRepository) loaded() []timex.TimeRange {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]timex.TimeRange(nil), r.periods...)
+}
+
+func TestBusinessDayIndexRepository_FindByPeriod(t *testing.T) {
+	period := timex.TimeRange{Begin: timex.Date(2025, 5, 1), End: timex.Date(2025, 5, 31)}
+
+	t.Run("同時に求められた索引は一度だけ作られる", func(t *testing.T) {
+		calendars := &recordingBusinessCalendarRepository{release: make(chan struct{})}
+		repo := datasource.NewBusinessDayIndexRepository(calendars, datasource.NewCacheRegistry())
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.FindByPeriod(context.Background(), model.DefaultCalendarID, period)
+				assert.NoError(t, err)
+			}()
+		}
+		close(calendars.release)
+		wg.Wait()
+
+		// Callers arriving after the build use the cached index
+		assert.Len(t, calendars.loaded(), 1)
+	})
+
+	t.Run("作り直すときに前の索引の期間まで広げない", func(t *testing.T) {
+		calendars := &recordingBusinessCalendarRepository{release: make(chan struct{})}
+		close(calendars.release)
+		repo := datasource.NewBusinessDayIndexRepository(calendars, datasource.NewCacheRegistry())
+
+		_, err := repo.FindByPeriod(context.Background(), model.DefaultCalendarID, timex.TimeRange{Begin: timex.Date(1950, 1, 1), End: timex.Date(1950, 1, 31)})
+		assert.NoError(t, err)
+		_, err = repo.FindByPeriod(context.Background(), model.DefaultCalendarID, timex.TimeRange{Begin: timex.Date(2150, 1, 1), End: timex.Date(2150, 1, 31)})
+		assert.NoError(t, err)
+
+		loaded := calendars.loaded()
+		assert.Len(t, loaded, 2)
+		assert.Equal(t, timex.Date(time.Now().Year()-5, time.January, 1), loaded[1].Begin)
+		assert.Equal(t, timex.Date(2150, 1, 31), loaded[1].End)
+	})
+
+	t.Run("読み込み中に無効化されると作り直される", func(t *testing.T) {
+		calendars := newBlockingBusinessCalendarRepository()
+		caches := datasource.NewCacheRegistry()
+		repo := datasource.NewBusinessDayIndexRepository(calendars, caches)
+
+		result := make(chan *model.BusinessDayIndex)
+		go func() {
+			index, err := repo.FindByPeriod(context.Background(), model.DefaultCalendarID, period)
+			assert.NoError(t, err)
+			result <- index
+		}()
+		calendars.change(caches, model.NationalHoliday{Date: timex.Date(2025, 5, 7), Summary: "臨時の祝日"})
+
+		index := <-result
+		actual, err := index.BusinessDaysBetween(timex.Date(2025, 5, 6), timex.Date(2025, 5, 7))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, actual)
+	})
+}
@@ -0,0 +1,104 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type yearKey struct {
+	calendarID string
+	year       int
+}
+
+type cachedYear struct {
+	days     *model.YearBusinessDays
+	loadedAt time.Time
+}
+
+type businessDaySetRepository struct {
+	calendars repository.BusinessCalendarRepository
+	now       func() time.Time
+	group     singleflight.Group
+
+	mu         sync.RWMutex
+	years      map[yearKey]cachedYear
+	generation uint64 // Incremented on invalidation, so that bitsets loaded before it are not stored
+}
+
+// NewBusinessDaySetRepository creates a BusinessDaySetRepository that loads the bitset of each calendar and year
+// on first use and keeps it in memory until the caches are invalidated or it expires.
+// Concurrent loads of the same bitset share a single query.
+func NewBusinessDaySetRepository(calendars repository.BusinessCalendarRepository, caches *CacheRegistry) repository.BusinessDaySetRepository {
+	r := &businessDaySetRepository{
+		calendars: calendars,
+		now:       time.Now,
+		years:     map[yearKey]cachedYear{},
+	}
+	caches.Register(r)
+	return r
+}
+
+func (r *businessDaySetRepository) FindByYear(ctx context.Context, calendarID string, year int) (*model.YearBusinessDays, error) {
+	key := yearKey{calendarID: calendarID, year: year}
+
+	for {
+		r.mu.RLock()
+		cached, ok := r.years[key]
+		generation := r.generation
+		r.mu.RUnlock()
+		if ok && r.now().Sub(cached.loadedAt) < cacheTTL {
+			return cached.days, nil
+		}
+
+		// The load is shared by every caller waiting for it, so it must not be cancelled with the first one.
+		// Callers arriving after an invalidation do not join a load started before it.
+		loadCtx := context.WithoutCancel(ctx)
+		v, err, _ := r.group.Do(fmt.Sprintf("%s/%d/%d", calendarID, year, generation), func() (interface{}, error) {
+			calendar, err := r.calendars.FindByPeriod(loadCtx, calendarID, timex.TimeRange{
+				Begin: timex.Date(year, time.January, 1),
+				End:   timex.Date(year, time.December, 31),
+			})
+			if err != nil {
+				return nil, err
+			}
+			days, err := model.NewYearBusinessDays(calendar, year)
+			if err != nil {
+				return nil, err
+			}
+
+			r.mu.Lock()
+			if r.generation == generation {
+				r.years[key] = cachedYear{days: days, loadedAt: r.now()}
+			}
+			r.mu.Unlock()
+			return days, nil
+		})
+		if err != nil {
+			return nil, xerrors.Errorf("failed to load business days of %d: %w", year, err)
+		}
+
+		// Load again when the caches were invalidated during the load, as the bitset may be stale
+		r.mu.RLock()
+		stale := r.generation != generation
+		r.mu.RUnlock()
+		if stale {
+			continue
+		}
+		return v.(*model.YearBusinessDays), nil
+	}
+}
+
+func (r *businessDaySetRepository) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.years = map[yearKey]cachedYear{}
+	r.generation++
+}
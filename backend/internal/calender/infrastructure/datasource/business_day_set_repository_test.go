@@ -0,0 +1,75 @@
+package datasource_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// blockingBusinessCalendarRepository holds the first load until it is released,
+// so that the tables can change while a cache is being filled
+type blockingBusinessCalendarRepository struct {
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+
+	mu               sync.Mutex
+	nationalHolidays []model.NationalHoliday
+}
+
+func newBlockingBusinessCalendarRepository() *blockingBusinessCalendarRepository {
+	return &blockingBusinessCalendarRepository{started: make(chan struct{}), release: make(chan struct{})}
+}
+
+func (r *blockingBusinessCalendarRepository) FindByPeriod(_ context.Context, _ string, period timex.TimeRange) (*model.BusinessCalendar, error) {
+	r.mu.Lock()
+	nationalHolidays := r.nationalHolidays
+	r.mu.Unlock()
+
+	r.once.Do(func() {
+		close(r.started)
+		<-r.release
+	})
+	return model.NewBusinessCalendar(period, nationalHolidays, nil, nil, nil), nil
+}
+
+// change adds a national holiday while the first load is held and invalidates the caches before releasing it
+func (r *blockingBusinessCalendarRepository) change(caches *datasource.CacheRegistry, holiday model.NationalHoliday) {
+	<-r.started
+	r.mu.Lock()
+	r.nationalHolidays = append(r.nationalHolidays, holiday)
+	r.mu.Unlock()
+	caches.Invalidate()
+	close(r.release)
+}
+
+func TestBusinessDaySetRepository_FindByYear(t *testing.T) {
+	t.Run("読み込み中に無効化されると読み込み直される", func(t *testing.T) {
+		calendars := newBlockingBusinessCalendarRepository()
+		caches := datasource.NewCacheRegistry()
+		repo := datasource.NewBusinessDaySetRepository(calendars, caches)
+
+		result := make(chan *model.YearBusinessDays)
+		go func() {
+			days, err := repo.FindByYear(context.Background(), model.DefaultCalendarID, 2025)
+			assert.NoError(t, err)
+			result <- days
+		}()
+		calendars.change(caches, model.NationalHoliday{Date: timex.Date(2025, 5, 7), Summary: "臨時の祝日"})
+
+		days := <-result
+		actual, err := days.IsBusinessDay(timex.Date(2025, 5, 7))
+		assert.NoError(t, err)
+		assert.False(t, actual)
+
+		// The reloaded bitset is cached
+		cached, err := repo.FindByYear(context.Background(), model.DefaultCalendarID, 2025)
+		assert.NoError(t, err)
+		assert.Same(t, days, cached)
+	})
+}
@@ -0,0 +1,82 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/calender/domain/service"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type businessHoursRepository struct {
+	q *query.Query
+}
+
+// NewBusinessHoursRepository creates a BusinessHoursRepository backed by the generated query package
+func NewBusinessHoursRepository(q *query.Query) repository.BusinessHoursRepository {
+	return &businessHoursRepository{q: q}
+}
+
+func (r *businessHoursRepository) FindByPeriod(ctx context.Context, calendarID string, period timex.TimeRange) (*model.BusinessHours, error) {
+	calendars, err := findCalendars(ctx, r.q)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := service.NewCalendarHierarchy(calendars).Chain(calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(chain))
+	layers := make([]model.CalendarLayer, 0, len(chain))
+	index := make(map[string]int, len(chain))
+	for i, c := range chain {
+		ids = append(ids, c.ID)
+		layers = append(layers, model.CalendarLayer{Calendar: c})
+		index[c.ID] = i
+	}
+
+	bh := r.q.BusinessHour
+	hourRows, err := bh.WithContext(ctx).
+		Where(bh.CalendarID.In(ids...)).
+		Order(bh.Weekday, bh.OpensAt).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find business hours: %w", err)
+	}
+
+	e := r.q.BusinessHourException
+	exceptionRows, err := e.WithContext(ctx).
+		Where(e.CalendarID.In(ids...), e.Date.Between(period.Begin, period.End)).
+		Order(e.Date, e.OpensAt).
+		Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find business hour exceptions: %w", err)
+	}
+
+	for _, row := range hourRows {
+		layer := &layers[index[row.CalendarID]]
+		layer.WeekdayHours = append(layer.WeekdayHours, model.WeekdayHours{
+			Weekday:      time.Weekday(row.Weekday),
+			OpeningHours: model.OpeningHours{Opens: timeOfDay(row.OpensAt), Closes: timeOfDay(row.ClosesAt)},
+		})
+	}
+	for _, row := range exceptionRows {
+		layer := &layers[index[row.CalendarID]]
+		layer.DateHours = append(layer.DateHours, model.DateHours{
+			Date:         timex.DateOf(row.Date),
+			OpeningHours: model.OpeningHours{Opens: timeOfDay(row.OpensAt), Closes: timeOfDay(row.ClosesAt)},
+		})
+	}
+
+	return service.ResolveBusinessHours(layers)
+}
+
+// timeOfDay converts a value read from a time column to an offset from midnight
+func timeOfDay(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
@@ -0,0 +1,38 @@
+package datasource
+
+import "sync"
+
+// invalidator is a cache that can be discarded
+type invalidator interface {
+	Invalidate()
+}
+
+// CacheRegistry keeps track of the in-memory caches derived from holidays and closed days,
+// so that all of them can be invalidated when the tables change
+type CacheRegistry struct {
+	mu     sync.Mutex
+	caches []invalidator
+}
+
+// NewCacheRegistry creates an empty CacheRegistry
+func NewCacheRegistry() *CacheRegistry {
+	return &CacheRegistry{}
+}
+
+// Register adds a cache to invalidate
+func (r *CacheRegistry) Register(c invalidator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.caches = append(r.caches, c)
+}
+
+// Invalidate discards every registered cache
+func (r *CacheRegistry) Invalidate() {
+	r.mu.Lock()
+	caches := append([]invalidator(nil), r.caches...)
+	r.mu.Unlock()
+
+	for _, c := range caches {
+		c.Invalidate()
+	}
+}
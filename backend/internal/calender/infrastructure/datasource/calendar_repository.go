@@ -0,0 +1,67 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/xerrors"
+	"gorm.io/gorm"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/entity"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+)
+
+type calendarRepository struct {
+	q *query.Query
+}
+
+// NewCalendarRepository creates a CalendarRepository backed by the generated query package
+func NewCalendarRepository(q *query.Query) repository.CalendarRepository {
+	return &calendarRepository{q: q}
+}
+
+func (r *calendarRepository) FindAll(ctx context.Context) ([]model.Calendar, error) {
+	return findCalendars(ctx, r.q)
+}
+
+func (r *calendarRepository) FindByID(ctx context.Context, id string) (*model.Calendar, error) {
+	return findCalendar(ctx, r.q, id)
+}
+
+// findCalendar loads a calendar, translating a missing row to model.ErrCalendarNotFound
+func findCalendar(ctx context.Context, q *query.Query, id string) (*model.Calendar, error) {
+	c := q.Calendar
+	row, err := c.WithContext(ctx).Where(c.ID.Eq(id)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, xerrors.Errorf("%s: %w", id, model.ErrCalendarNotFound)
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find calendar: %w", err)
+	}
+	calendar := newCalendar(row)
+	return &calendar, nil
+}
+
+// findCalendars loads every calendar in identifier order
+func findCalendars(ctx context.Context, q *query.Query) ([]model.Calendar, error) {
+	c := q.Calendar
+	rows, err := c.WithContext(ctx).Order(c.ID).Find()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to find calendars: %w", err)
+	}
+
+	calendars := make([]model.Calendar, 0, len(rows))
+	for _, row := range rows {
+		calendars = append(calendars, newCalendar(row))
+	}
+	return calendars, nil
+}
+
+func newCalendar(row *entity.Calendar) model.Calendar {
+	c := model.Calendar{ID: row.ID, Name: row.Name, TimeZone: row.TimeZone}
+	if row.ParentID != nil {
+		c.ParentID = *row.ParentID
+	}
+	return c
+}
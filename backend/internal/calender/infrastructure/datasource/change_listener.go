@@ -0,0 +1,72 @@
+package datasource
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/xerrors"
+)
+
+const (
+	// changeChannel is notified by the triggers on the holiday and closed day tables
+	changeChannel = "calender_changed"
+	// minReconnectDelay and maxReconnectDelay bound the backoff between attempts to reconnect the listener
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// ChangeListener invalidates the in-memory caches when another instance or a direct edit changes the holiday tables
+type ChangeListener struct {
+	dsn    string
+	caches *CacheRegistry
+}
+
+// NewChangeListener creates a ChangeListener that listens on a dedicated connection opened with the dsn
+func NewChangeListener(dsn string, caches *CacheRegistry) *ChangeListener {
+	return &ChangeListener{dsn: dsn, caches: caches}
+}
+
+// Run listens for changes until the context is done, reconnecting when the connection drops.
+// The caches are invalidated on every connection, as notifications sent while disconnected are lost.
+func (l *ChangeListener) Run(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		err := l.listen(ctx, func() { delay = minReconnectDelay })
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Warn("lost calendar change notifications, reconnecting", "error", err, "delay", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+func (l *ChangeListener) listen(ctx context.Context, connected func()) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return xerrors.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "listen "+changeChannel); err != nil {
+		return xerrors.Errorf("failed to listen: %w", err)
+	}
+	connected()
+	l.caches.Invalidate()
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return xerrors.Errorf("failed to wait for notification: %w", err)
+		}
+		slog.Debug("calendar changed", "payload", n.Payload)
+		l.caches.Invalidate()
+	}
+}
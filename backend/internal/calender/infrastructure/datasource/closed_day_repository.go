@@ -0,0 +1,195 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+	"gorm.io/gen/field"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/entity"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type closedDayRepository struct {
+	q      *query.Query
+	caches *CacheRegistry
+}
+
+// NewClosedDayRepository creates a ClosedDayRepository backed by the generated query package.
+// The in-memory caches are invalidated whenever closed days are saved.
+func NewClosedDayRepository(q *query.Query, caches *CacheRegistry) repository.ClosedDayRepository {
+	return &closedDayRepository{q: q, caches: caches}
+}
+
+func (r *closedDayRepository) Save(ctx context.Context, calendarID string, closures []model.Closure) error {
+	err := r.q.Transaction(func(tx *query.Query) error {
+		if _, err := findCalendar(ctx, tx, calendarID); err != nil {
+			return err
+		}
+		for _, c := range closures {
+			if err := saveClosure(ctx, tx, calendarID, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		return err
+	}
+	if err != nil {
+		return xerrors.Errorf("failed to save closed days: %w", err)
+	}
+
+	// Closed days of a calendar are inherited by its children, so every cache may have changed
+	r.caches.Invalidate()
+	return nil
+}
+
+// saveClosure replaces the closures overlapping the closure with what remains of them and inserts the closure
+func saveClosure(ctx context.Context, tx *query.Query, calendarID string, c model.Closure) error {
+	cd := tx.ClosedDay
+	overlapping, err := cd.WithContext(ctx).
+		Where(cd.CalendarID.Eq(calendarID), overlaps(c.Period())).
+		Find()
+	if err != nil {
+		return err
+	}
+
+	var rows []*entity.ClosedDay
+	for _, row := range overlapping {
+		existing, err := closureOf(row)
+		if err != nil {
+			return err
+		}
+		if _, err := cd.WithContext(ctx).Where(cd.ID.Eq(row.ID)).Delete(); err != nil {
+			return err
+		}
+		for _, rest := range existing.Without(c.Period()) {
+			restRow, err := closedDayRow(calendarID, rest)
+			if err != nil {
+				return err
+			}
+			rows = append(rows, restRow)
+		}
+	}
+
+	row, err := closedDayRow(calendarID, c)
+	if err != nil {
+		return err
+	}
+	return cd.WithContext(ctx).Create(append(rows, row)...)
+}
+
+// overlaps selects the closed days whose period overlaps the given one
+func overlaps(period timex.TimeRange) field.Expr {
+	return field.NewUnsafeFieldRaw("closed_days.period && daterange(?::date, ?::date, '[]')",
+		period.Begin.Format(time.DateOnly), period.End.Format(time.DateOnly))
+}
+
+// closureOf converts a closed_days row to a closure
+func closureOf(row *entity.ClosedDay) (model.Closure, error) {
+	period, err := parseDateRange(row.Period)
+	if err != nil {
+		return model.Closure{}, xerrors.Errorf("invalid period of closed day %d: %w", row.ID, err)
+	}
+	names, err := parseNames(row.Names)
+	if err != nil {
+		return model.Closure{}, xerrors.Errorf("invalid names of closed day %d: %w", row.ID, err)
+	}
+
+	c := model.Closure{
+		StartsOn: period.Begin,
+		EndsOn:   period.End,
+		Summary:  row.Summary,
+		Category: model.HolidayCategory(row.Category),
+		Names:    names,
+	}
+	if row.OpensAt != nil {
+		c.OpensAt = timeOfDay(*row.OpensAt)
+	}
+	if row.ClosesAt != nil {
+		c.ClosesAt = timeOfDay(*row.ClosesAt)
+	}
+	return c, nil
+}
+
+// closedDayRow converts a closure to a closed_days row
+func closedDayRow(calendarID string, c model.Closure) (*entity.ClosedDay, error) {
+	names, err := formatNames(c.Names)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid names of closed day %s: %w", c.StartsOn.Format(time.DateOnly), err)
+	}
+
+	row := &entity.ClosedDay{
+		CalendarID: calendarID,
+		Period:     formatDateRange(c.Period()),
+		Summary:    c.Summary,
+		Category:   string(c.Category),
+		Names:      names,
+	}
+	if c.OpensAt > 0 {
+		t := clockTime(c.OpensAt)
+		row.OpensAt = &t
+	}
+	if c.ClosesAt > 0 {
+		t := clockTime(c.ClosesAt)
+		row.ClosesAt = &t
+	}
+	return row, nil
+}
+
+// parseDateRange decodes a daterange column. Postgres returns ranges of dates with an exclusive upper bound,
+// such as "[2025-08-13,2025-08-16)", which is converted to an inclusive period.
+func parseDateRange(s string) (timex.TimeRange, error) {
+	if len(s) < 2 {
+		return timex.TimeRange{}, xerrors.Errorf("invalid date range: %s", s)
+	}
+	lower, upper, ok := strings.Cut(s[1:len(s)-1], ",")
+	if !ok {
+		return timex.TimeRange{}, xerrors.Errorf("invalid date range: %s", s)
+	}
+
+	begin, err := time.ParseInLocation(time.DateOnly, lower, timex.JST)
+	if err != nil {
+		return timex.TimeRange{}, xerrors.Errorf("invalid date range: %s", s)
+	}
+	end, err := time.ParseInLocation(time.DateOnly, upper, timex.JST)
+	if err != nil {
+		return timex.TimeRange{}, xerrors.Errorf("invalid date range: %s", s)
+	}
+	if s[0] == '(' {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	if s[len(s)-1] == ')' {
+		end = end.AddDate(0, 0, -1)
+	}
+	return timex.TimeRange{Begin: begin, End: end}, nil
+}
+
+// formatDateRange encodes an inclusive period for a daterange column
+func formatDateRange(period timex.TimeRange) string {
+	return "[" + period.Begin.Format(time.DateOnly) + "," + period.End.Format(time.DateOnly) + "]"
+}
+
+// clockTime returns the time of day of an offset from midnight for a time column
+func clockTime(d time.Duration) time.Time {
+	return time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC).Add(d)
+}
+
+// formatNames encodes names by language for a names jsonb column
+func formatNames(names model.LocalizedNames) (string, error) {
+	if len(names) == 0 {
+		return "{}", nil
+	}
+	b, err := json.Marshal(names)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -12,8 +12,14 @@ const TableNameClosedDay = "closed_days"
 
 // ClosedDay mapped from table <closed_days>
 type ClosedDay struct {
-	Date    time.Time `gorm:"column:date;primaryKey" json:"date"`
-	Summary string    `gorm:"column:summary;not null" json:"summary"`
+	CalendarID string     `gorm:"column:calendar_id;not null" json:"calendar_id"`
+	Summary    string     `gorm:"column:summary;not null" json:"summary"`
+	OpensAt    *time.Time `gorm:"column:opens_at" json:"opens_at"`
+	ClosesAt   *time.Time `gorm:"column:closes_at" json:"closes_at"`
+	Category   string     `gorm:"column:category;not null;default:'company'::calender.holiday_category" json:"category"`
+	Names      string     `gorm:"column:names;not null;default:'{}'::jsonb" json:"names"`
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	Period     string     `gorm:"column:period;not null" json:"period"`
 }
 
 // TableName ClosedDay's table name
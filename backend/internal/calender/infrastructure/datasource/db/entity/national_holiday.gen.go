@@ -12,8 +12,10 @@ const TableNameNationalHoliday = "national_holiday"
 
 // NationalHoliday mapped from table <national_holiday>
 type NationalHoliday struct {
-	Date    time.Time `gorm:"column:date;primaryKey" json:"date"`
-	Summary string    `gorm:"column:summary;not null" json:"summary"`
+	Date     time.Time `gorm:"column:date;primaryKey" json:"date"`
+	Summary  string    `gorm:"column:summary;not null" json:"summary"`
+	Category string    `gorm:"column:category;not null;default:'statutory'::calender.holiday_category" json:"category"`
+	Names    string    `gorm:"column:names;not null;default:'{}'::jsonb" json:"names"`
 }
 
 // TableName NationalHoliday's table name
@@ -27,8 +27,14 @@ func newClosedDay(db *gorm.DB, opts ...gen.DOOption) closedDay {
 
 	tableName := _closedDay.closedDayDo.TableName()
 	_closedDay.ALL = field.NewAsterisk(tableName)
-	_closedDay.Date = field.NewTime(tableName, "date")
+	_closedDay.CalendarID = field.NewString(tableName, "calendar_id")
 	_closedDay.Summary = field.NewString(tableName, "summary")
+	_closedDay.OpensAt = field.NewTime(tableName, "opens_at")
+	_closedDay.ClosesAt = field.NewTime(tableName, "closes_at")
+	_closedDay.Category = field.NewString(tableName, "category")
+	_closedDay.Names = field.NewString(tableName, "names")
+	_closedDay.ID = field.NewInt64(tableName, "id")
+	_closedDay.Period = field.NewString(tableName, "period")
 
 	_closedDay.fillFieldMap()
 
@@ -38,9 +44,15 @@ func newClosedDay(db *gorm.DB, opts ...gen.DOOption) closedDay {
 type closedDay struct {
 	closedDayDo closedDayDo
 
-	ALL     field.Asterisk
-	Date    field.Time
-	Summary field.String
+	ALL        field.Asterisk
+	CalendarID field.String
+	Summary    field.String
+	OpensAt    field.Time
+	ClosesAt   field.Time
+	Category   field.String
+	Names      field.String
+	ID         field.Int64
+	Period     field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -57,8 +69,14 @@ func (c closedDay) As(alias string) *closedDay {
 
 func (c *closedDay) updateTableName(table string) *closedDay {
 	c.ALL = field.NewAsterisk(table)
-	c.Date = field.NewTime(table, "date")
+	c.CalendarID = field.NewString(table, "calendar_id")
 	c.Summary = field.NewString(table, "summary")
+	c.OpensAt = field.NewTime(table, "opens_at")
+	c.ClosesAt = field.NewTime(table, "closes_at")
+	c.Category = field.NewString(table, "category")
+	c.Names = field.NewString(table, "names")
+	c.ID = field.NewInt64(table, "id")
+	c.Period = field.NewString(table, "period")
 
 	c.fillFieldMap()
 
@@ -85,9 +103,15 @@ func (c *closedDay) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *closedDay) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 2)
-	c.fieldMap["date"] = c.Date
+	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap["calendar_id"] = c.CalendarID
 	c.fieldMap["summary"] = c.Summary
+	c.fieldMap["opens_at"] = c.OpensAt
+	c.fieldMap["closes_at"] = c.ClosesAt
+	c.fieldMap["category"] = c.Category
+	c.fieldMap["names"] = c.Names
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["period"] = c.Period
 }
 
 func (c closedDay) clone(db *gorm.DB) closedDay {
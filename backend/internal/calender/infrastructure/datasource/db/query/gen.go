@@ -16,39 +16,74 @@ import (
 )
 
 var (
-	Q               = new(Query)
-	ClosedDay       *closedDay
-	NationalHoliday *nationalHoliday
+	Q                      = new(Query)
+	BusinessHour           *businessHour
+	BusinessHourException  *businessHourException
+	Calendar               *calendar
+	ClosedDay              *closedDay
+	ClosedDayRule          *closedDayRule
+	ClosedDayRuleException *closedDayRuleException
+	NationalHoliday        *nationalHoliday
+	WeeklyPattern          *weeklyPattern
+	WorkingDayOverride     *workingDayOverride
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	BusinessHour = &Q.BusinessHour
+	BusinessHourException = &Q.BusinessHourException
+	Calendar = &Q.Calendar
 	ClosedDay = &Q.ClosedDay
+	ClosedDayRule = &Q.ClosedDayRule
+	ClosedDayRuleException = &Q.ClosedDayRuleException
 	NationalHoliday = &Q.NationalHoliday
+	WeeklyPattern = &Q.WeeklyPattern
+	WorkingDayOverride = &Q.WorkingDayOverride
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:              db,
-		ClosedDay:       newClosedDay(db, opts...),
-		NationalHoliday: newNationalHoliday(db, opts...),
+		db:                     db,
+		BusinessHour:           newBusinessHour(db, opts...),
+		BusinessHourException:  newBusinessHourException(db, opts...),
+		Calendar:               newCalendar(db, opts...),
+		ClosedDay:              newClosedDay(db, opts...),
+		ClosedDayRule:          newClosedDayRule(db, opts...),
+		ClosedDayRuleException: newClosedDayRuleException(db, opts...),
+		NationalHoliday:        newNationalHoliday(db, opts...),
+		WeeklyPattern:          newWeeklyPattern(db, opts...),
+		WorkingDayOverride:     newWorkingDayOverride(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ClosedDay       closedDay
-	NationalHoliday nationalHoliday
+	BusinessHour           businessHour
+	BusinessHourException  businessHourException
+	Calendar               calendar
+	ClosedDay              closedDay
+	ClosedDayRule          closedDayRule
+	ClosedDayRuleException closedDayRuleException
+	NationalHoliday        nationalHoliday
+	WeeklyPattern          weeklyPattern
+	WorkingDayOverride     workingDayOverride
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:              db,
-		ClosedDay:       q.ClosedDay.clone(db),
-		NationalHoliday: q.NationalHoliday.clone(db),
+		db:                     db,
+		BusinessHour:           q.BusinessHour.clone(db),
+		BusinessHourException:  q.BusinessHourException.clone(db),
+		Calendar:               q.Calendar.clone(db),
+		ClosedDay:              q.ClosedDay.clone(db),
+		ClosedDayRule:          q.ClosedDayRule.clone(db),
+		ClosedDayRuleException: q.ClosedDayRuleException.clone(db),
+		NationalHoliday:        q.NationalHoliday.clone(db),
+		WeeklyPattern:          q.WeeklyPattern.clone(db),
+		WorkingDayOverride:     q.WorkingDayOverride.clone(db),
 	}
 }
 
@@ -62,21 +97,42 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:              db,
-		ClosedDay:       q.ClosedDay.replaceDB(db),
-		NationalHoliday: q.NationalHoliday.replaceDB(db),
+		db:                     db,
+		BusinessHour:           q.BusinessHour.replaceDB(db),
+		BusinessHourException:  q.BusinessHourException.replaceDB(db),
+		Calendar:               q.Calendar.replaceDB(db),
+		ClosedDay:              q.ClosedDay.replaceDB(db),
+		ClosedDayRule:          q.ClosedDayRule.replaceDB(db),
+		ClosedDayRuleException: q.ClosedDayRuleException.replaceDB(db),
+		NationalHoliday:        q.NationalHoliday.replaceDB(db),
+		WeeklyPattern:          q.WeeklyPattern.replaceDB(db),
+		WorkingDayOverride:     q.WorkingDayOverride.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ClosedDay       *closedDayDo
-	NationalHoliday *nationalHolidayDo
+	BusinessHour           *businessHourDo
+	BusinessHourException  *businessHourExceptionDo
+	Calendar               *calendarDo
+	ClosedDay              *closedDayDo
+	ClosedDayRule          *closedDayRuleDo
+	ClosedDayRuleException *closedDayRuleExceptionDo
+	NationalHoliday        *nationalHolidayDo
+	WeeklyPattern          *weeklyPatternDo
+	WorkingDayOverride     *workingDayOverrideDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ClosedDay:       q.ClosedDay.WithContext(ctx),
-		NationalHoliday: q.NationalHoliday.WithContext(ctx),
+		BusinessHour:           q.BusinessHour.WithContext(ctx),
+		BusinessHourException:  q.BusinessHourException.WithContext(ctx),
+		Calendar:               q.Calendar.WithContext(ctx),
+		ClosedDay:              q.ClosedDay.WithContext(ctx),
+		ClosedDayRule:          q.ClosedDayRule.WithContext(ctx),
+		ClosedDayRuleException: q.ClosedDayRuleException.WithContext(ctx),
+		NationalHoliday:        q.NationalHoliday.WithContext(ctx),
+		WeeklyPattern:          q.WeeklyPattern.WithContext(ctx),
+		WorkingDayOverride:     q.WorkingDayOverride.WithContext(ctx),
 	}
 }
 
@@ -29,6 +29,8 @@ func newNationalHoliday(db *gorm.DB, opts ...gen.DOOption) nationalHoliday {
 	_nationalHoliday.ALL = field.NewAsterisk(tableName)
 	_nationalHoliday.Date = field.NewTime(tableName, "date")
 	_nationalHoliday.Summary = field.NewString(tableName, "summary")
+	_nationalHoliday.Category = field.NewString(tableName, "category")
+	_nationalHoliday.Names = field.NewString(tableName, "names")
 
 	_nationalHoliday.fillFieldMap()
 
@@ -38,9 +40,11 @@ func newNationalHoliday(db *gorm.DB, opts ...gen.DOOption) nationalHoliday {
 type nationalHoliday struct {
 	nationalHolidayDo nationalHolidayDo
 
-	ALL     field.Asterisk
-	Date    field.Time
-	Summary field.String
+	ALL      field.Asterisk
+	Date     field.Time
+	Summary  field.String
+	Category field.String
+	Names    field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -59,6 +63,8 @@ func (n *nationalHoliday) updateTableName(table string) *nationalHoliday {
 	n.ALL = field.NewAsterisk(table)
 	n.Date = field.NewTime(table, "date")
 	n.Summary = field.NewString(table, "summary")
+	n.Category = field.NewString(table, "category")
+	n.Names = field.NewString(table, "names")
 
 	n.fillFieldMap()
 
@@ -87,9 +93,11 @@ func (n *nationalHoliday) GetFieldByName(fieldName string) (field.OrderExpr, boo
 }
 
 func (n *nationalHoliday) fillFieldMap() {
-	n.fieldMap = make(map[string]field.Expr, 2)
+	n.fieldMap = make(map[string]field.Expr, 4)
 	n.fieldMap["date"] = n.Date
 	n.fieldMap["summary"] = n.Summary
+	n.fieldMap["category"] = n.Category
+	n.fieldMap["names"] = n.Names
 }
 
 func (n nationalHoliday) clone(db *gorm.DB) nationalHoliday {
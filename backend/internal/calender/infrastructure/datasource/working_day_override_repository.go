@@ -0,0 +1,63 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/xerrors"
+	"gorm.io/gorm/clause"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/domain/repository"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/entity"
+	"net.bright-room.dev/calender-api/internal/calender/infrastructure/datasource/db/query"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type workingDayOverrideRepository struct {
+	q      *query.Query
+	caches *CacheRegistry
+}
+
+// NewWorkingDayOverrideRepository creates a WorkingDayOverrideRepository backed by the generated query package.
+// The in-memory caches are invalidated whenever overrides are saved.
+func NewWorkingDayOverrideRepository(q *query.Query, caches *CacheRegistry) repository.WorkingDayOverrideRepository {
+	return &workingDayOverrideRepository{q: q, caches: caches}
+}
+
+func (r *workingDayOverrideRepository) Save(ctx context.Context, calendarID string, overrides []model.WorkingDayOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	rows := make([]*entity.WorkingDayOverride, 0, len(overrides))
+	for _, o := range overrides {
+		rows = append(rows, &entity.WorkingDayOverride{
+			CalendarID: calendarID,
+			Date:       timex.DateOf(o.Date),
+			Summary:    o.Summary,
+		})
+	}
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		if _, err := findCalendar(ctx, tx, calendarID); err != nil {
+			return err
+		}
+		w := tx.WorkingDayOverride
+		return w.WithContext(ctx).
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: w.CalendarID.ColumnName().String()}, {Name: w.Date.ColumnName().String()}},
+				DoUpdates: clause.AssignmentColumns([]string{w.Summary.ColumnName().String()}),
+			}).
+			Create(rows...)
+	})
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		return err
+	}
+	if err != nil {
+		return xerrors.Errorf("failed to save working-day overrides: %w", err)
+	}
+
+	// Overrides of a calendar are inherited by its children, so every cache may have changed
+	r.caches.Invalidate()
+	return nil
+}
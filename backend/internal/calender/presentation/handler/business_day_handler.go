@@ -0,0 +1,433 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// BusinessDayHandler serves business-day date arithmetic
+type BusinessDayHandler struct {
+	businessDays *usecase.BusinessDayUsecase
+}
+
+// NewBusinessDayHandler creates a BusinessDayHandler
+func NewBusinessDayHandler(businessDays *usecase.BusinessDayUsecase) *BusinessDayHandler {
+	return &BusinessDayHandler{businessDays: businessDays}
+}
+
+type businessDayCheckResponse struct {
+	Calendar      string `json:"calendar"`
+	Date          string `json:"date"`
+	IsBusinessDay bool   `json:"isBusinessDay"`
+}
+
+type rollResponse struct {
+	Calendar   string `json:"calendar"`
+	Date       string `json:"date"`
+	Convention string `json:"convention"`
+	Rolled     string `json:"rolled"`
+}
+
+type scheduledDateResponse struct {
+	Unadjusted string `json:"unadjusted"`
+	Adjusted   string `json:"adjusted"`
+}
+
+type scheduleResponse struct {
+	Calendar   string                  `json:"calendar"`
+	Day        int                     `json:"day"`
+	Convention string                  `json:"convention"`
+	Dates      []scheduledDateResponse `json:"dates"`
+}
+
+type monthBusinessDayResponse struct {
+	Year  int     `json:"year"`
+	Month int     `json:"month"`
+	Date  *string `json:"date"` // null when the month has fewer business days
+}
+
+type nthBusinessDayResponse struct {
+	Calendar string                     `json:"calendar"`
+	N        int                        `json:"n"`
+	Months   []monthBusinessDayResponse `json:"months"`
+}
+
+type monthOrdinalResponse struct {
+	Calendar      string `json:"calendar"`
+	Date          string `json:"date"`
+	IsBusinessDay bool   `json:"isBusinessDay"`
+	Ordinal       int    `json:"ordinal"`
+	FromEnd       int    `json:"fromEnd"`
+	BusinessDays  int    `json:"businessDays"`
+}
+
+type addBusinessDaysResponse struct {
+	Calendar string `json:"calendar"`
+	Date     string `json:"date"`
+	Days     int    `json:"days"`
+	Result   string `json:"result"`
+}
+
+type businessDaysBetweenResponse struct {
+	Calendar string `json:"calendar"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Days     int    `json:"days"`
+}
+
+// paymentTermRequest is the body of POST /v1/payment-terms/calculate
+type paymentTermRequest struct {
+	Calendar           string   `json:"calendar"`
+	ClosingDay         int      `json:"closingDay"`
+	PaymentMonthOffset int      `json:"paymentMonthOffset"`
+	PaymentDay         int      `json:"paymentDay"`
+	Adjustment         string   `json:"adjustment"`
+	TransactionDates   []string `json:"transactionDates"`
+}
+
+type paymentDatesResponse struct {
+	TransactionDate       string `json:"transactionDate"`
+	ClosingDate           string `json:"closingDate"`
+	UnadjustedPaymentDate string `json:"unadjustedPaymentDate"`
+	PaymentDate           string `json:"paymentDate"`
+}
+
+type paymentTermResponse struct {
+	Calendar string                 `json:"calendar"`
+	Results  []paymentDatesResponse `json:"results"`
+}
+
+// maxPaymentTermRequestBytes bounds the size of a payment term request body
+const maxPaymentTermRequestBytes = 1 << 20
+
+// GetCheck handles GET /v1/business-days/check?date=..., answered from the in-memory business days of the year
+func (h *BusinessDayHandler) GetCheck(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	date, err := parseDate("date", q.Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	ok, err := h.businessDays.IsBusinessDay(r.Context(), calendarID, date)
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, businessDayCheckResponse{Calendar: calendarID, Date: date.Format("2006-01-02"), IsBusinessDay: ok})
+}
+
+// GetRoll handles GET /v1/business-days/roll?date=...&convention=...
+func (h *BusinessDayHandler) GetRoll(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	date, err := parseDate("date", q.Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	convention, err := model.ParseRollConvention(q.Get("convention"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	rolled, err := h.businessDays.Roll(r.Context(), calendarID, date, convention)
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rollResponse{
+		Calendar:   calendarID,
+		Date:       date.Format("2006-01-02"),
+		Convention: string(convention),
+		Rolled:     rolled.Format("2006-01-02"),
+	})
+}
+
+// GetSchedule handles GET /v1/business-days/schedule?from=...&to=...&day=...&convention=...
+func (h *BusinessDayHandler) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, err := parseDate("from", q.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	to, err := parseDate("to", q.Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if from.After(to) {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("from must not be after to"))
+		return
+	}
+	day, err := strconv.Atoi(q.Get("day"))
+	if err != nil || day < 1 || day > 31 {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("invalid day: %s", q.Get("day")))
+		return
+	}
+	convention, err := model.ParseRollConvention(q.Get("convention"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	dates, err := h.businessDays.Schedule(r.Context(), usecase.ScheduleQuery{
+		CalendarID: calendarID,
+		Period:     timex.TimeRange{Begin: from, End: to},
+		Schedule:   model.MonthlySchedule{Day: day, Convention: convention},
+	})
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	res := scheduleResponse{
+		Calendar:   calendarID,
+		Day:        day,
+		Convention: string(convention),
+		Dates:      make([]scheduledDateResponse, 0, len(dates)),
+	}
+	for _, d := range dates {
+		res.Dates = append(res.Dates, scheduledDateResponse{
+			Unadjusted: d.Unadjusted.Format("2006-01-02"),
+			Adjusted:   d.Adjusted.Format("2006-01-02"),
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// GetNth handles GET /v1/business-days/nth?from=YYYY-MM&to=YYYY-MM&n=...
+// n counts from the end of the month when negative, and to defaults to from.
+func (h *BusinessDayHandler) GetNth(w http.ResponseWriter, r *http.Request) {
+	n, err := strconv.Atoi(r.URL.Query().Get("n"))
+	if err != nil || n == 0 {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("invalid n: %s", r.URL.Query().Get("n")))
+		return
+	}
+	h.writeNthBusinessDays(w, r, n)
+}
+
+// GetMonthEnd handles GET /v1/business-days/month-end?from=YYYY-MM&to=YYYY-MM, the last business day of every month
+func (h *BusinessDayHandler) GetMonthEnd(w http.ResponseWriter, r *http.Request) {
+	h.writeNthBusinessDays(w, r, -1)
+}
+
+func (h *BusinessDayHandler) writeNthBusinessDays(w http.ResponseWriter, r *http.Request, n int) {
+	q := r.URL.Query()
+	from, err := parseYearMonth("from", q.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	to := from
+	if s := q.Get("to"); s != "" {
+		if to, err = parseYearMonth("to", s); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	if from.After(to) {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("from must not be after to"))
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	days, err := h.businessDays.NthBusinessDays(r.Context(), usecase.NthBusinessDayQuery{
+		CalendarID: calendarID,
+		Months:     timex.TimeRange{Begin: from, End: to},
+		N:          n,
+	})
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	res := nthBusinessDayResponse{Calendar: calendarID, N: n, Months: make([]monthBusinessDayResponse, 0, len(days))}
+	for _, d := range days {
+		month := monthBusinessDayResponse{Year: d.Year, Month: int(d.Month)}
+		if !d.Date.IsZero() {
+			date := d.Date.Format("2006-01-02")
+			month.Date = &date
+		}
+		res.Months = append(res.Months, month)
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// GetOrdinal handles GET /v1/business-days/ordinal?date=...
+func (h *BusinessDayHandler) GetOrdinal(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	date, err := parseDate("date", q.Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	ordinal, err := h.businessDays.MonthOrdinal(r.Context(), calendarID, date)
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, monthOrdinalResponse{
+		Calendar:      calendarID,
+		Date:          ordinal.Date.Format("2006-01-02"),
+		IsBusinessDay: ordinal.IsBusinessDay,
+		Ordinal:       ordinal.Ordinal,
+		FromEnd:       ordinal.FromEnd,
+		BusinessDays:  ordinal.BusinessDays,
+	})
+}
+
+// GetAdd handles GET /v1/business-days/add?date=...&days=..., days being negative to go back.
+// The absolute value of days is at most usecase.MaxBusinessDaysToAdd.
+func (h *BusinessDayHandler) GetAdd(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	date, err := parseDate("date", q.Get("date"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	days, err := strconv.Atoi(q.Get("days"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("invalid days: %s", q.Get("days")))
+		return
+	}
+	if days > usecase.MaxBusinessDaysToAdd || days < -usecase.MaxBusinessDaysToAdd {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("days must be between -%d and %d", usecase.MaxBusinessDaysToAdd, usecase.MaxBusinessDaysToAdd))
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	result, err := h.businessDays.AddBusinessDays(r.Context(), calendarID, date, days)
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, addBusinessDaysResponse{
+		Calendar: calendarID,
+		Date:     date.Format("2006-01-02"),
+		Days:     days,
+		Result:   result.Format("2006-01-02"),
+	})
+}
+
+// GetBetween handles GET /v1/business-days/between?from=...&to=..., counting the business days after from up to and including to
+func (h *BusinessDayHandler) GetBetween(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, err := parseDate("from", q.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	to, err := parseDate("to", q.Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	days, err := h.businessDays.BusinessDaysBetween(r.Context(), calendarID, from, to)
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, businessDaysBetweenResponse{
+		Calendar: calendarID,
+		From:     from.Format("2006-01-02"),
+		To:       to.Format("2006-01-02"),
+		Days:     days,
+	})
+}
+
+// writeBusinessDayError maps the errors of business-day arithmetic to responses
+func writeBusinessDayError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, model.ErrCalendarNotFound):
+		writeError(w, http.StatusNotFound, err)
+	case errors.Is(err, model.ErrOutOfPeriod):
+		writeError(w, http.StatusUnprocessableEntity, err)
+	case errors.Is(err, model.ErrPeriodTooLong):
+		writeError(w, http.StatusBadRequest, err)
+	default:
+		writeError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// PostPaymentTerms handles POST /v1/payment-terms/calculate.
+// Days of 31 mean the end of the month, and an empty adjustment leaves payment dates on non-business days as they are.
+func (h *BusinessDayHandler) PostPaymentTerms(w http.ResponseWriter, r *http.Request) {
+	var req paymentTermRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPaymentTermRequestBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	term := model.PaymentTerm{
+		ClosingDay:         req.ClosingDay,
+		PaymentMonthOffset: req.PaymentMonthOffset,
+		PaymentDay:         req.PaymentDay,
+		Adjustment:         model.RollConvention(req.Adjustment),
+	}
+	if err := term.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if len(req.TransactionDates) > usecase.MaxPaymentTransactions {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("more than %d transaction dates", usecase.MaxPaymentTransactions))
+		return
+	}
+	transactions := make([]time.Time, 0, len(req.TransactionDates))
+	for _, s := range req.TransactionDates {
+		t, err := parseDate("transactionDates", s)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		transactions = append(transactions, t)
+	}
+
+	calendarID := req.Calendar
+	if calendarID == "" {
+		calendarID = model.DefaultCalendarID
+	}
+	dates, err := h.businessDays.PaymentDates(r.Context(), usecase.PaymentTermQuery{
+		CalendarID:   calendarID,
+		Term:         term,
+		Transactions: transactions,
+	})
+	if err != nil {
+		writeBusinessDayError(w, err)
+		return
+	}
+
+	res := paymentTermResponse{Calendar: calendarID, Results: make([]paymentDatesResponse, 0, len(dates))}
+	for _, d := range dates {
+		res.Results = append(res.Results, paymentDatesResponse{
+			TransactionDate:       d.Transaction.Format("2006-01-02"),
+			ClosingDate:           d.Closing.Format("2006-01-02"),
+			UnadjustedPaymentDate: d.UnadjustedPayment.Format("2006-01-02"),
+			PaymentDate:           d.Payment.Format("2006-01-02"),
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/presentation/handler"
+)
+
+func TestBusinessDayHandler_Limits(t *testing.T) {
+	// The limits are checked before the calendar is loaded, so no repository is needed
+	h := handler.NewBusinessDayHandler(usecase.NewBusinessDayUsecase(nil, nil, nil))
+
+	tests := []struct {
+		name     string
+		handle   http.HandlerFunc
+		request  *http.Request
+		expected int
+	}{
+		{
+			name:     "上限を超える期間のスケジュールは400になる",
+			handle:   h.GetSchedule,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/schedule?from=1990-01-01&to=2025-12-31&day=25&convention=following", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:   "上限を超える期間にわたる取引の支払日は400になる",
+			handle: h.PostPaymentTerms,
+			request: httptest.NewRequest(http.MethodPost, "/v1/payment-terms/calculate", strings.NewReader(
+				`{"closingDay":31,"paymentMonthOffset":1,"paymentDay":31,"transactionDates":["1990-01-10","2025-01-10"]}`,
+			)),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "上限を超える月数のn営業日は400になる",
+			handle:   h.GetNth,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/nth?from=1990-01&to=2025-12&n=5", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "上限を超える営業日数の加算は400になる",
+			handle:   h.GetAdd,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/add?date=2025-05-02&days=100000000", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "上限を超える営業日数の減算は400になる",
+			handle:   h.GetAdd,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/add?date=2025-05-02&days=-9223372036854775808", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "上限を超える期間の営業日数は400になる",
+			handle:   h.GetBetween,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/between?from=1990-01-01&to=2025-12-31", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "対応範囲より前の日付の営業日数は400になる",
+			handle:   h.GetBetween,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/between?from=0001-01-01&to=0001-01-02", nil),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "対応範囲より後の日付の営業日加算は400になる",
+			handle:   h.GetAdd,
+			request:  httptest.NewRequest(http.MethodGet, "/v1/business-days/add?date=9999-12-01&days=1", nil),
+			expected: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handle(w, tt.request)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
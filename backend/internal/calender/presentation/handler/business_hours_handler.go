@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// BusinessHoursHandler serves business-hour arithmetic
+type BusinessHoursHandler struct {
+	hours *usecase.BusinessHoursUsecase
+}
+
+// NewBusinessHoursHandler creates a BusinessHoursHandler
+func NewBusinessHoursHandler(hours *usecase.BusinessHoursUsecase) *BusinessHoursHandler {
+	return &BusinessHoursHandler{hours: hours}
+}
+
+type businessHoursResponse struct {
+	Calendar string `json:"calendar"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Duration string `json:"duration"`
+	Seconds  int64  `json:"seconds"`
+}
+
+func newBusinessHoursResponse(calendarID string, from, to time.Time, d time.Duration) businessHoursResponse {
+	return businessHoursResponse{
+		Calendar: calendarID,
+		From:     from.Format(time.RFC3339),
+		To:       to.Format(time.RFC3339),
+		Duration: d.String(),
+		Seconds:  int64(d / time.Second),
+	}
+}
+
+// GetAdd handles GET /v1/business-hours/add?from=...&duration=...
+func (h *BusinessHoursHandler) GetAdd(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, err := parseDateTime("from", q.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	d, err := parseDuration("duration", q.Get("duration"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if d > usecase.MaxBusinessHoursDuration {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("duration is longer than %s", usecase.MaxBusinessHoursDuration))
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	to, err := h.hours.AddBusinessHours(r.Context(), calendarID, from, d)
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, model.ErrPeriodTooLong) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if errors.Is(err, model.ErrOutOfPeriod) {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newBusinessHoursResponse(calendarID, from, to, d))
+}
+
+// GetBetween handles GET /v1/business-hours/between?from=...&to=...
+func (h *BusinessHoursHandler) GetBetween(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, err := parseDateTime("from", q.Get("from"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	to, err := parseDateTime("to", q.Get("to"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	calendarID := parseCalendarID(q)
+	d, err := h.hours.BusinessDurationBetween(r.Context(), calendarID, from, to)
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if errors.Is(err, model.ErrPeriodTooLong) {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if errors.Is(err, model.ErrOutOfPeriod) {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newBusinessHoursResponse(calendarID, from, to, d))
+}
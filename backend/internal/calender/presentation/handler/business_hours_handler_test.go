@@ -0,0 +1,74 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/presentation/handler"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// fixedBusinessCalendarRepository loads calendars for a fixed period whatever period is requested
+type fixedBusinessCalendarRepository struct {
+	period timex.TimeRange
+}
+
+func (r *fixedBusinessCalendarRepository) FindByPeriod(context.Context, string, timex.TimeRange) (*model.BusinessCalendar, error) {
+	return model.NewBusinessCalendar(r.period, nil, nil, nil, nil), nil
+}
+
+type fakeBusinessHoursRepository struct{}
+
+func (r *fakeBusinessHoursRepository) FindByPeriod(context.Context, string, timex.TimeRange) (*model.BusinessHours, error) {
+	return model.NewBusinessHours(timex.JST, nil, nil), nil
+}
+
+func TestBusinessHoursHandler_Limits(t *testing.T) {
+	// The limits are checked before the calendar is loaded, so no repository is needed
+	h := handler.NewBusinessHoursHandler(usecase.NewBusinessHoursUsecase(nil, nil))
+
+	tests := []struct {
+		name     string
+		handle   http.HandlerFunc
+		target   string
+		expected int
+	}{
+		{
+			name:     "上限を超える時間の加算は400になる",
+			handle:   h.GetAdd,
+			target:   "/v1/business-hours/add?from=2025-04-01T09:00:00%2B09:00&duration=8785h",
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "上限を超える期間の営業時間は400になる",
+			handle:   h.GetBetween,
+			target:   "/v1/business-hours/between?from=2000-04-01T09:00:00%2B09:00&to=2025-04-01T09:00:00%2B09:00",
+			expected: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handle(w, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestBusinessHoursHandler_GetBetween(t *testing.T) {
+	t.Run("読み込まれた期間の外の日時は422になる", func(t *testing.T) {
+		calendars := &fixedBusinessCalendarRepository{period: timex.TimeRange{Begin: timex.Date(2025, 4, 1), End: timex.Date(2025, 4, 30)}}
+		h := handler.NewBusinessHoursHandler(usecase.NewBusinessHoursUsecase(calendars, &fakeBusinessHoursRepository{}))
+
+		w := httptest.NewRecorder()
+		h.GetBetween(w, httptest.NewRequest(http.MethodGet, "/v1/business-hours/between?from=2025-04-01T09:00:00%2B09:00&to=2025-05-07T09:00:00%2B09:00", nil))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
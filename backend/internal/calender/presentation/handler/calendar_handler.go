@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// CalendarHandler serves the list of calendars and their month and year views
+type CalendarHandler struct {
+	calendars *usecase.CalendarListUsecase
+	views     *usecase.CalendarViewUsecase
+}
+
+// NewCalendarHandler creates a CalendarHandler
+func NewCalendarHandler(calendars *usecase.CalendarListUsecase, views *usecase.CalendarViewUsecase) *CalendarHandler {
+	return &CalendarHandler{calendars: calendars, views: views}
+}
+
+type calendarResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ParentID string `json:"parentId,omitempty"`
+}
+
+type calendarListResponse struct {
+	Calendars []calendarResponse `json:"calendars"`
+}
+
+type dayResponse struct {
+	Date          string `json:"date"`
+	Weekday       string `json:"weekday"`
+	IsBusinessDay bool   `json:"isBusinessDay"`
+	Kind          string `json:"kind"`
+	Summary       string `json:"summary,omitempty"`
+	Category      string `json:"category,omitempty"`
+	OpensAt       string `json:"opensAt,omitempty"`
+	ClosesAt      string `json:"closesAt,omitempty"`
+	InMonth       bool   `json:"inMonth"`
+}
+
+type monthResponse struct {
+	Year         int             `json:"year"`
+	Month        int             `json:"month"`
+	WeekStart    string          `json:"weekStart"`
+	BusinessDays float64         `json:"businessDays"`
+	Weeks        [][]dayResponse `json:"weeks"`
+}
+
+type yearResponse struct {
+	Year   int             `json:"year"`
+	Months []monthResponse `json:"months"`
+}
+
+func newMonthResponse(v model.MonthView, lang model.Language) monthResponse {
+	res := monthResponse{
+		Year:         v.Year,
+		Month:        int(v.Month),
+		WeekStart:    strings.ToLower(v.WeekStart.String()),
+		BusinessDays: v.BusinessDays,
+		Weeks:        make([][]dayResponse, 0, len(v.Weeks)),
+	}
+	for _, week := range v.Weeks {
+		days := make([]dayResponse, 0, len(week))
+		for _, d := range week {
+			days = append(days, dayResponse{
+				Date:          d.Date.Format("2006-01-02"),
+				Weekday:       strings.ToLower(d.Weekday().String()),
+				IsBusinessDay: d.IsBusinessDay,
+				Kind:          string(d.Kind),
+				Summary:       d.Name(lang),
+				Category:      string(d.Category),
+				OpensAt:       formatTimeOfDay(d.OpensAt),
+				ClosesAt:      formatTimeOfDay(d.ClosesAt),
+				InMonth:       d.InMonth,
+			})
+		}
+		res.Weeks = append(res.Weeks, days)
+	}
+	return res
+}
+
+// List handles GET /v1/calendars
+func (h *CalendarHandler) List(w http.ResponseWriter, r *http.Request) {
+	calendars, err := h.calendars.List(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := calendarListResponse{Calendars: make([]calendarResponse, 0, len(calendars))}
+	for _, c := range calendars {
+		res.Calendars = append(res.Calendars, calendarResponse{ID: c.ID, Name: c.Name, ParentID: c.ParentID})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// formatTimeOfDay formats an offset from midnight as "15:04", or an empty string for zero
+func formatTimeOfDay(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// GetMonth handles GET /v1/calendars/{yyyy}/{mm}
+func (h *CalendarHandler) GetMonth(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("yyyy"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	month, err := parseMonth(r.PathValue("mm"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	weekStart, err := parseWeekStart(r.URL.Query().Get("week_start"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	lang, err := parseLanguage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	view, err := h.views.Month(r.Context(), parseCalendarID(r.URL.Query()), year, month, weekStart)
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, http.StatusOK, newMonthResponse(view, lang))
+}
+
+// GetYear handles GET /v1/calendars/{yyyy}
+func (h *CalendarHandler) GetYear(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("yyyy"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	weekStart, err := parseWeekStart(r.URL.Query().Get("week_start"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	lang, err := parseLanguage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	views, err := h.views.Year(r.Context(), parseCalendarID(r.URL.Query()), year, weekStart)
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := yearResponse{Year: year, Months: make([]monthResponse, 0, len(views))}
+	for _, v := range views {
+		res.Months = append(res.Months, newMonthResponse(v, lang))
+	}
+	w.Header().Set("Content-Language", string(lang))
+	writeJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"mime"
+	"net/http"
+	"time"
+
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+)
+
+// FeedHandler serves subscribable iCalendar feeds
+type FeedHandler struct {
+	feeds *usecase.CalendarFeedUsecase
+}
+
+// NewFeedHandler creates a FeedHandler
+func NewFeedHandler(feeds *usecase.CalendarFeedUsecase) *FeedHandler {
+	return &FeedHandler{feeds: feeds}
+}
+
+// GetFeed handles GET /v1/calendars/{id}.ics
+func (h *FeedHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+
+	period, err := parsePeriod(r.URL.Query(), usecase.DefaultFeedPeriod(now))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	kinds, categories, err := usecase.ParseFeedCategories(r.URL.Query().Get("category"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	lang, err := parseLanguage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	feed, err := h.feeds.Feed(r.Context(), usecase.FeedQuery{
+		CalendarID: r.PathValue("id"),
+		Period:     period,
+		Kinds:      kinds,
+		Categories: categories,
+		Language:   lang,
+	}, now)
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := feed.Encode(&buf); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Language", string(lang))
+	// The id is quoted, or encoded when it is not ASCII, so that it cannot break out of the header
+	if disposition := mime.FormatMediaType("inline", map[string]string{"filename": r.PathValue("id") + ".ics"}); disposition != "" {
+		w.Header().Set("Content-Disposition", disposition)
+	}
+	_, _ = w.Write(buf.Bytes())
+}
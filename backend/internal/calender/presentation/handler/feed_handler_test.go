@@ -0,0 +1,75 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/calender/presentation/handler"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+type fakeCalendarRepository struct {
+	calendars []model.Calendar
+}
+
+func (r *fakeCalendarRepository) FindAll(context.Context) ([]model.Calendar, error) {
+	return r.calendars, nil
+}
+
+func (r *fakeCalendarRepository) FindByID(_ context.Context, id string) (*model.Calendar, error) {
+	for _, c := range r.calendars {
+		if c.ID == id {
+			return &c, nil
+		}
+	}
+	return nil, model.ErrCalendarNotFound
+}
+
+type fakeBusinessCalendarRepository struct{}
+
+func (r *fakeBusinessCalendarRepository) FindByPeriod(_ context.Context, _ string, period timex.TimeRange) (*model.BusinessCalendar, error) {
+	return model.NewBusinessCalendar(period, nil, nil, nil, nil), nil
+}
+
+func TestFeedHandler_GetFeed(t *testing.T) {
+	calendars := &fakeCalendarRepository{calendars: []model.Calendar{
+		{ID: model.DefaultCalendarID, Name: "本社"},
+		{ID: "a\"b\r\nX-Injected: 1", Name: "不正な識別子"},
+	}}
+	h := handler.NewFeedHandler(usecase.NewCalendarFeedUsecase(calendars, &fakeBusinessCalendarRepository{}))
+
+	get := func(id, query string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/v1/calendars/feed.ics?"+query, nil)
+		r.SetPathValue("id", id)
+		w := httptest.NewRecorder()
+		h.GetFeed(w, r)
+		return w
+	}
+
+	t.Run("ファイル名に識別子が使われる", func(t *testing.T) {
+		w := get(model.DefaultCalendarID, "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "inline; filename=default.ics", w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("ヘッダーに使えない文字を含む識別子はエンコードされる", func(t *testing.T) {
+		w := get("a\"b\r\nX-Injected: 1", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `inline; filename*=utf-8''a%22b%0D%0AX-Injected%3A%201.ics`, w.Header().Get("Content-Disposition"))
+		assert.Empty(t, w.Header().Get("X-Injected"))
+	})
+
+	t.Run("長すぎる期間は400になる", func(t *testing.T) {
+		w := get(model.DefaultCalendarID, url.Values{"from": {"1990-01-01"}, "to": {"2025-12-31"}}.Encode())
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/csvx"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// HolidayHandler serves the holidays of a calendar as JSON or CSV
+type HolidayHandler struct {
+	holidays *usecase.HolidayListUsecase
+}
+
+// NewHolidayHandler creates a HolidayHandler
+func NewHolidayHandler(holidays *usecase.HolidayListUsecase) *HolidayHandler {
+	return &HolidayHandler{holidays: holidays}
+}
+
+type holidayResponse struct {
+	Date     string `json:"date"`
+	Kind     string `json:"kind"`
+	Category string `json:"category,omitempty"`
+	Summary  string `json:"summary"`
+}
+
+type holidayListResponse struct {
+	Holidays []holidayResponse `json:"holidays"`
+}
+
+// holidayRecord is a row of the CSV export
+type holidayRecord struct {
+	Date     time.Time `csv:"date" format:"2006-01-02"`
+	Kind     string    `csv:"kind"`
+	Category string    `csv:"category"`
+	Summary  string    `csv:"summary"`
+}
+
+// GetHolidays handles GET /v1/holidays.
+// The from and to parameters default to the current year, kind and category filter the entries,
+// lang or Accept-Language selects the language of the summaries and format=csv returns the entries as CSV with a header row.
+func (h *HolidayHandler) GetHolidays(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	now := time.Now().In(timex.JST)
+	period, err := parsePeriod(q, timex.TimeRange{
+		Begin: timex.Date(now.Year(), time.January, 1),
+		End:   timex.Date(now.Year(), time.December, 31),
+	})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	kinds, err := parseKinds("kind", q.Get("kind"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	categories, err := parseHolidayCategories("category", q.Get("category"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	lang, err := parseLanguage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	format := q.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("invalid format: %s", format))
+		return
+	}
+
+	entries, err := h.holidays.List(r.Context(), usecase.HolidayQuery{
+		CalendarID: parseCalendarID(q),
+		Period:     period,
+		Kinds:      kinds,
+		Categories: categories,
+		Language:   lang,
+	})
+	if errors.Is(err, model.ErrCalendarNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Language", string(lang))
+	if format == "csv" {
+		writeHolidayCSV(w, entries)
+		return
+	}
+
+	res := holidayListResponse{Holidays: make([]holidayResponse, 0, len(entries))}
+	for _, e := range entries {
+		res.Holidays = append(res.Holidays, holidayResponse{
+			Date:     e.Date.Format("2006-01-02"),
+			Kind:     string(e.Kind),
+			Category: string(e.Category),
+			Summary:  e.Summary,
+		})
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// writeHolidayCSV writes the entries as CSV, just the header row when there are none
+func writeHolidayCSV(w http.ResponseWriter, entries []usecase.HolidayEntry) {
+	records := make([]holidayRecord, 0, len(entries))
+	for _, e := range entries {
+		records = append(records, holidayRecord{Date: e.Date, Kind: string(e.Kind), Category: string(e.Category), Summary: e.Summary})
+	}
+
+	var buf bytes.Buffer
+	writer := csvx.NewDefaultWriter()
+	writer.HasHeader = true
+	if err := writer.Write(&buf, records); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="holidays.csv"`)
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,32 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/calender/application/usecase"
+	"net.bright-room.dev/calender-api/internal/calender/presentation/handler"
+)
+
+func TestHolidayHandler_GetHolidays(t *testing.T) {
+	h := handler.NewHolidayHandler(usecase.NewHolidayListUsecase(&fakeBusinessCalendarRepository{}))
+
+	t.Run("長すぎる期間は400になる", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/holidays?from=1990-01-01&to=2025-12-31", nil)
+		w := httptest.NewRecorder()
+		h.GetHolidays(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("休日のないCSVはヘッダー行だけになる", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/holidays?from=2025-06-01&to=2025-06-30&format=csv", nil)
+		w := httptest.NewRecorder()
+		h.GetHolidays(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "date,kind,category,summary\n", w.Body.String())
+	})
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/xerrors"
+	"net.bright-room.dev/calender-api/internal/calender/domain/model"
+	"net.bright-room.dev/calender-api/internal/timex"
+)
+
+// parseYear parses a four digit year path parameter
+func parseYear(s string) (int, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil || len(s) != 4 {
+		return 0, xerrors.Errorf("invalid year: %s", s)
+	}
+	return year, nil
+}
+
+// parseMonth parses a month path parameter such as "1" or "01"
+func parseMonth(s string) (time.Month, error) {
+	month, err := strconv.Atoi(s)
+	if err != nil || len(s) > 2 || month < 1 || month > 12 {
+		return 0, xerrors.Errorf("invalid month: %s", s)
+	}
+	return time.Month(month), nil
+}
+
+// parseCalendarID returns the calendar query parameter, or the default calendar when it is missing
+func parseCalendarID(q url.Values) string {
+	if id := q.Get("calendar"); id != "" {
+		return id
+	}
+	return model.DefaultCalendarID
+}
+
+// parseLanguage returns the language of names requested by the lang query parameter, or else the Accept-Language header.
+// Unsupported languages fall back to the default language, and an Accept-Language header that cannot be parsed is ignored.
+func parseLanguage(r *http.Request) (model.Language, error) {
+	if s := r.URL.Query().Get("lang"); s != "" {
+		tag, err := language.Parse(s)
+		if err != nil {
+			return "", xerrors.Errorf("invalid lang: %s", s)
+		}
+		lang, _ := model.MatchLanguage(tag)
+		return lang, nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return model.DefaultLanguage, nil
+	}
+	lang, _ := model.MatchLanguage(tags...)
+	return lang, nil
+}
+
+// parseWeekStart parses the week_start query parameter. Weeks start on Sunday by default.
+func parseWeekStart(s string) (time.Weekday, error) {
+	switch s {
+	case "", "sunday":
+		return time.Sunday, nil
+	case "monday":
+		return time.Monday, nil
+	default:
+		return 0, xerrors.Errorf("invalid week_start: %s", s)
+	}
+}
+
+// supportedDates bounds the dates of query parameters. National holidays start with the 1948 act, and the bound
+// keeps the business-day indexes, which span the years around now and every requested date, small.
+var supportedDates = timex.TimeRange{Begin: timex.Date(1948, time.January, 1), End: timex.Date(2150, time.December, 31)}
+
+// parseDate parses a date query parameter in YYYY-MM-DD format, rejecting dates outside supportedDates
+func parseDate(name, s string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", s, timex.JST)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("invalid %s: %s", name, s)
+	}
+	if t.Before(supportedDates.Begin) || t.After(supportedDates.End) {
+		return time.Time{}, xerrors.Errorf("%s must be between %s and %s", name, supportedDates.Begin.Format("2006-01-02"), supportedDates.End.Format("2006-01-02"))
+	}
+	return t, nil
+}
+
+// parseYearMonth parses a month query parameter in YYYY-MM format, returning the first day of the month
+func parseYearMonth(name, s string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01", s, timex.JST)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("invalid %s: %s", name, s)
+	}
+	return t, nil
+}
+
+// parseDateTime parses a date-time query parameter in RFC 3339 format, e.g. 2025-04-01T09:00:00+09:00
+func parseDateTime(name, s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("invalid %s: %s", name, s)
+	}
+	return t, nil
+}
+
+// parseDuration parses a non-negative duration query parameter such as "16h" or "1h30m"
+func parseDuration(name, s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, xerrors.Errorf("invalid %s: %s", name, s)
+	}
+	return d, nil
+}
+
+// maxPeriodDays bounds the period of a listing or feed, as every holiday and recurring closure in it is expanded
+const maxPeriodDays = 366 * 10
+
+// parsePeriod parses the from and to query parameters, falling back to the given default for missing ones.
+// Periods longer than maxPeriodDays are rejected.
+func parsePeriod(q url.Values, fallback timex.TimeRange) (timex.TimeRange, error) {
+	period := fallback
+	if s := q.Get("from"); s != "" {
+		from, err := parseDate("from", s)
+		if err != nil {
+			return timex.TimeRange{}, err
+		}
+		period.Begin = from
+	}
+	if s := q.Get("to"); s != "" {
+		to, err := parseDate("to", s)
+		if err != nil {
+			return timex.TimeRange{}, err
+		}
+		period.End = to
+	}
+	if period.Begin.After(period.End) {
+		return timex.TimeRange{}, xerrors.Errorf("from must not be after to")
+	}
+	if timex.DateOf(period.End).Sub(timex.DateOf(period.Begin)) > maxPeriodDays*timex.DAY {
+		return timex.TimeRange{}, xerrors.Errorf("period is longer than %d days", maxPeriodDays)
+	}
+	return period, nil
+}
+
+// parseKinds parses a comma separated query parameter of the kinds of days that have entries
+func parseKinds(name, s string) ([]model.DayKind, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var kinds []model.DayKind
+	for _, v := range strings.Split(s, ",") {
+		kind, err := model.ParseEntryKind(strings.TrimSpace(v))
+		if err != nil {
+			return nil, xerrors.Errorf("invalid %s: %s", name, v)
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds, nil
+}
+
+// parseHolidayCategories parses a comma separated query parameter of holiday categories
+func parseHolidayCategories(name, s string) ([]model.HolidayCategory, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var categories []model.HolidayCategory
+	for _, v := range strings.Split(s, ",") {
+		category, err := model.ParseHolidayCategory(strings.TrimSpace(v))
+		if err != nil {
+			return nil, xerrors.Errorf("invalid %s: %s", name, v)
+		}
+		categories = append(categories, category)
+	}
+	return categories, nil
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+)
+
+// NewRouter registers every endpoint of the API
+func NewRouter(calendar *CalendarHandler, feed *FeedHandler, hours *BusinessHoursHandler, holidays *HolidayHandler, businessDays *BusinessDayHandler) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /v1/calendars", calendar.List)
+
+	// Wildcards must span a whole segment, so "{id}.ics" and "{yyyy}" share a route
+	mux.HandleFunc("GET /v1/calendars/{segment}", func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := strings.CutSuffix(r.PathValue("segment"), ".ics"); ok {
+			r.SetPathValue("id", id)
+			feed.GetFeed(w, r)
+			return
+		}
+		r.SetPathValue("yyyy", r.PathValue("segment"))
+		calendar.GetYear(w, r)
+	})
+	mux.HandleFunc("GET /v1/calendars/{yyyy}/{mm}", calendar.GetMonth)
+
+	mux.HandleFunc("GET /v1/holidays", holidays.GetHolidays)
+
+	mux.HandleFunc("GET /v1/business-days/check", businessDays.GetCheck)
+	mux.HandleFunc("GET /v1/business-days/add", businessDays.GetAdd)
+	mux.HandleFunc("GET /v1/business-days/between", businessDays.GetBetween)
+	mux.HandleFunc("GET /v1/business-days/roll", businessDays.GetRoll)
+	mux.HandleFunc("GET /v1/business-days/schedule", businessDays.GetSchedule)
+	mux.HandleFunc("GET /v1/business-days/nth", businessDays.GetNth)
+	mux.HandleFunc("GET /v1/business-days/month-end", businessDays.GetMonthEnd)
+	mux.HandleFunc("GET /v1/business-days/ordinal", businessDays.GetOrdinal)
+	mux.HandleFunc("POST /v1/payment-terms/calculate", businessDays.PostPaymentTerms)
+
+	mux.HandleFunc("GET /v1/business-hours/add", hours.GetAdd)
+	mux.HandleFunc("GET /v1/business-hours/between", hours.GetBetween)
+
+	return mux
+}
@@ -0,0 +1,210 @@
+package csvx
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"golang.org/x/xerrors"
+)
+
+// ChangeType describes how a row differs between two datasets
+type ChangeType string
+
+// Predefined change types
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// FieldChange is the change of a single field. Values are compared in their CSV representation.
+type FieldChange struct {
+	Header string // CSV header name
+	Old    string // Value in the old dataset, empty for added rows
+	New    string // Value in the new dataset, empty for removed rows
+}
+
+// RowDiff is the difference of a single row identified by its key
+type RowDiff struct {
+	Type    ChangeType    // How the row changed
+	Key     string        // Value of the key column
+	Changes []FieldChange // Changed fields, every field for added and removed rows
+}
+
+// DiffResult is the keyed difference of two datasets
+type DiffResult struct {
+	Key  string    // Header name of the key column
+	Rows []RowDiff // Differences ordered by key
+}
+
+// DiffRecord is the CSV representation of a single field change
+type DiffRecord struct {
+	Change ChangeType `csv:"change"`
+	Key    string     `csv:"key"`
+	Field  string     `csv:"field"`
+	Old    string     `csv:"old"`
+	New    string     `csv:"new"`
+}
+
+// Diff compares two slices of the same struct type row by row, matching rows by the column with the given header name
+func Diff(oldData, newData interface{}, key string) (*DiffResult, error) {
+	oldValue, elemType, err := diffSlice(oldData)
+	if err != nil {
+		return nil, err
+	}
+	newValue, newElemType, err := diffSlice(newData)
+	if err != nil {
+		return nil, err
+	}
+	if elemType != newElemType {
+		return nil, xerrors.Errorf("datasets must have the same element type, got %s and %s", elemType, newElemType)
+	}
+
+	// Parse struct tags
+	fields, err := parseStructTags(elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	keyIndex := -1
+	for i, field := range fields {
+		if field.header == key {
+			keyIndex = i
+			break
+		}
+	}
+	if keyIndex < 0 {
+		return nil, xerrors.Errorf("key column is missing: %s", key)
+	}
+
+	oldRows, err := indexRows(oldValue, fields, keyIndex)
+	if err != nil {
+		return nil, err
+	}
+	newRows, err := indexRows(newValue, fields, keyIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DiffResult{Key: key}
+
+	for k, oldRow := range oldRows {
+		newRow, ok := newRows[k]
+		if !ok {
+			result.Rows = append(result.Rows, RowDiff{Type: ChangeRemoved, Key: k, Changes: rowChanges(fields, oldRow, nil)})
+			continue
+		}
+
+		if changes := rowChanges(fields, oldRow, newRow); len(changes) > 0 {
+			result.Rows = append(result.Rows, RowDiff{Type: ChangeModified, Key: k, Changes: changes})
+		}
+	}
+
+	for k, newRow := range newRows {
+		if _, ok := oldRows[k]; !ok {
+			result.Rows = append(result.Rows, RowDiff{Type: ChangeAdded, Key: k, Changes: rowChanges(fields, nil, newRow)})
+		}
+	}
+
+	sort.Slice(result.Rows, func(i, j int) bool {
+		return result.Rows[i].Key < result.Rows[j].Key
+	})
+
+	return result, nil
+}
+
+// HasChanges reports whether the datasets differ
+func (d *DiffResult) HasChanges() bool {
+	return len(d.Rows) > 0
+}
+
+// Records flattens the result into one record per field change
+func (d *DiffResult) Records() []DiffRecord {
+	var records []DiffRecord
+	for _, row := range d.Rows {
+		for _, change := range row.Changes {
+			records = append(records, DiffRecord{
+				Change: row.Type,
+				Key:    row.Key,
+				Field:  change.Header,
+				Old:    change.Old,
+				New:    change.New,
+			})
+		}
+	}
+	return records
+}
+
+// WriteDiff writes the diff as CSV with one row per field change. Nothing is written when there are no changes.
+func (w *Writer) WriteDiff(writer io.Writer, d *DiffResult) error {
+	if !d.HasChanges() {
+		return nil
+	}
+	return w.Write(writer, d.Records())
+}
+
+// diffSlice returns the slice value and its struct element type
+func diffSlice(data interface{}) (reflect.Value, reflect.Type, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return reflect.Value{}, nil, fmt.Errorf("data must be a slice, got %T", data)
+	}
+
+	elemType := v.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	return v, elemType, nil
+}
+
+// indexRows converts every row to its CSV representation keyed by the key column
+func indexRows(v reflect.Value, fields []fieldInfo, keyIndex int) (map[string][]string, error) {
+	rows := make(map[string][]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		rowValue := v.Index(i)
+		if rowValue.Kind() == reflect.Ptr {
+			rowValue = rowValue.Elem()
+		}
+
+		row := make([]string, len(fields))
+		for j, field := range fields {
+			s, err := getFieldStringValue(rowValue.FieldByName(field.name), field.format)
+			if err != nil {
+				return nil, fmt.Errorf("error getting string value for field %s: %w", field.name, err)
+			}
+			row[j] = s
+		}
+
+		k := row[keyIndex]
+		if _, ok := rows[k]; ok {
+			return nil, xerrors.Errorf("duplicate key: %s", k)
+		}
+		rows[k] = row
+	}
+	return rows, nil
+}
+
+// rowChanges returns the changed fields of a row. A nil row stands for a missing row.
+func rowChanges(fields []fieldInfo, oldRow, newRow []string) []FieldChange {
+	var changes []FieldChange
+	for i, field := range fields {
+		var oldValue, newValue string
+		if oldRow != nil {
+			oldValue = oldRow[i]
+		}
+		if newRow != nil {
+			newValue = newRow[i]
+		}
+
+		if oldRow != nil && newRow != nil && oldValue == newValue {
+			continue
+		}
+		changes = append(changes, FieldChange{Header: field.header, Old: oldValue, New: newValue})
+	}
+	return changes
+}
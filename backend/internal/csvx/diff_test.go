@@ -0,0 +1,103 @@
+package csvx_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/csvx"
+)
+
+type holidayRow struct {
+	Date    time.Time `csv:"date" format:"2006-01-02"`
+	Summary string    `csv:"summary"`
+}
+
+func readHolidayRows(t *testing.T, filePath string) []holidayRow {
+	file, _ := os.Open(filePath)
+	defer func(file *os.File) {
+		_ = file.Close()
+	}(file)
+
+	var rows []holidayRow
+	err := csvx.NewDefaultReader().Read(file, &rows)
+	assert.NoError(t, err)
+
+	return rows
+}
+
+func TestDiff(t *testing.T) {
+	oldRows := readHolidayRows(t, "./testdata/diff_old.csv")
+	newRows := readHolidayRows(t, "./testdata/diff_new.csv")
+
+	t.Run("追加・削除・変更された行が検出される", func(t *testing.T) {
+		actual, err := csvx.Diff(oldRows, newRows, "date")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []csvx.RowDiff{
+			{
+				Type:    csvx.ChangeModified,
+				Key:     "2025-01-13",
+				Changes: []csvx.FieldChange{{Header: "summary", Old: "成人の日", New: "成人の日（変更）"}},
+			},
+			{
+				Type: csvx.ChangeRemoved,
+				Key:  "2025-02-11",
+				Changes: []csvx.FieldChange{
+					{Header: "date", Old: "2025-02-11"},
+					{Header: "summary", Old: "建国記念の日"},
+				},
+			},
+			{
+				Type: csvx.ChangeAdded,
+				Key:  "2025-02-23",
+				Changes: []csvx.FieldChange{
+					{Header: "date", New: "2025-02-23"},
+					{Header: "summary", New: "天皇誕生日"},
+				},
+			},
+		}, actual.Rows)
+	})
+
+	t.Run("差分をCSVとして出力できる", func(t *testing.T) {
+		d, _ := csvx.Diff(oldRows, newRows, "date")
+
+		writer := csvx.NewDefaultWriter()
+		writer.HasHeader = true
+
+		var b strings.Builder
+		err := writer.WriteDiff(&b, d)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"change,key,field,old,new",
+			"modified,2025-01-13,summary,成人の日,成人の日（変更）",
+			"removed,2025-02-11,date,2025-02-11,",
+			"removed,2025-02-11,summary,建国記念の日,",
+			"added,2025-02-23,date,,2025-02-23",
+			"added,2025-02-23,summary,,天皇誕生日",
+			"",
+		}, "\n"), b.String())
+	})
+
+	t.Run("同一のデータセットには差分がない", func(t *testing.T) {
+		actual, err := csvx.Diff(oldRows, oldRows, "date")
+
+		assert.NoError(t, err)
+		assert.False(t, actual.HasChanges())
+	})
+
+	t.Run("キー列が存在しない場合エラーになる", func(t *testing.T) {
+		_, err := csvx.Diff(oldRows, newRows, "id")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("キーが重複している場合エラーになる", func(t *testing.T) {
+		_, err := csvx.Diff(append(oldRows, oldRows[0]), newRows, "date")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package csvx
+
+import (
+	"reflect"
+
+	"golang.org/x/xerrors"
+)
+
+// AfterReader is implemented by row types that post-process or validate a row after all of its fields are set
+type AfterReader interface {
+	AfterRead() error
+}
+
+// BeforeWriter is implemented by row types that derive or validate fields before the row is written
+type BeforeWriter interface {
+	BeforeWrite() error
+}
+
+// RowHook is a row middleware applied by Reader and Writer.
+// The row is passed as a pointer to the struct, so hooks may modify it.
+// When Reader.Workers is greater than 1, hooks are called concurrently from several goroutines.
+type RowHook func(row interface{}) error
+
+// afterRead runs the AfterRead method of the row followed by the reader hooks
+func afterRead(row reflect.Value, hooks []RowHook) error {
+	ptr := row.Addr().Interface()
+
+	if r, ok := ptr.(AfterReader); ok {
+		if err := r.AfterRead(); err != nil {
+			return xerrors.Errorf("after read hook failed: %w", err)
+		}
+	}
+
+	for _, hook := range hooks {
+		if err := hook(ptr); err != nil {
+			return xerrors.Errorf("row hook failed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// beforeWrite runs the BeforeWrite method of the row followed by the writer hooks.
+// Hooks operate on a copy, so the caller's data is never modified.
+func beforeWrite(row reflect.Value, hooks []RowHook) (reflect.Value, error) {
+	_, isBeforeWriter := reflect.New(row.Type()).Interface().(BeforeWriter)
+	if !isBeforeWriter && len(hooks) == 0 {
+		return row, nil
+	}
+
+	copied := reflect.New(row.Type())
+	copied.Elem().Set(row)
+	ptr := copied.Interface()
+
+	if w, ok := ptr.(BeforeWriter); ok {
+		if err := w.BeforeWrite(); err != nil {
+			return reflect.Value{}, xerrors.Errorf("before write hook failed: %w", err)
+		}
+	}
+
+	for _, hook := range hooks {
+		if err := hook(ptr); err != nil {
+			return reflect.Value{}, xerrors.Errorf("row hook failed: %w", err)
+		}
+	}
+
+	return copied.Elem(), nil
+}
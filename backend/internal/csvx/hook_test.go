@@ -0,0 +1,144 @@
+package csvx_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/unicode"
+	"net.bright-room.dev/calender-api/internal/csvx"
+)
+
+type closedDayRow struct {
+	Begin   time.Time `csv:"begin" format:"2006-01-02"`
+	Days    int       `csv:"days"`
+	End     time.Time `csv:"-"`
+	Summary string    `csv:"summary"`
+}
+
+func (r *closedDayRow) AfterRead() error {
+	r.End = r.Begin.AddDate(0, 0, r.Days-1)
+	if r.End.Before(r.Begin) {
+		return errors.New("end date is before begin date")
+	}
+	return nil
+}
+
+func (r *closedDayRow) BeforeWrite() error {
+	if r.End.Before(r.Begin) {
+		return errors.New("end date is before begin date")
+	}
+	r.Days = int(r.End.Sub(r.Begin).Hours()/24) + 1
+	return nil
+}
+
+func TestReader_AfterReadHook(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+		hooks    []csvx.RowHook
+		expected []closedDayRow
+		hasError bool
+	}{
+		{
+			name:     "AfterReadで終了日が算出される",
+			filePath: "./testdata/closed_days_hook.csv",
+			expected: []closedDayRow{
+				{
+					Begin:   time.Date(2025, 8, 9, 0, 0, 0, 0, time.UTC),
+					Days:    9,
+					End:     time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+					Summary: "夏季休業",
+				},
+				{
+					Begin:   time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC),
+					Days:    6,
+					End:     time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+					Summary: "年末年始休業",
+				},
+			},
+		},
+		{
+			name:     "AfterReadのエラーが返される",
+			filePath: "./testdata/closed_days_hook_invalid.csv",
+			hasError: true,
+		},
+		{
+			name:     "Readerのフックでエラーが返される",
+			filePath: "./testdata/closed_days_hook.csv",
+			hooks: []csvx.RowHook{
+				func(row interface{}) error {
+					if row.(*closedDayRow).Days > 7 {
+						return errors.New("closure is too long")
+					}
+					return nil
+				},
+			},
+			hasError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := csvx.Reader{
+				Encoding:  unicode.UTF8.NewDecoder(),
+				Delimiter: csvx.DelimiterComma,
+				HasHeader: true,
+				RowHooks:  tt.hooks,
+			}
+
+			file, _ := os.Open(tt.filePath)
+			defer func(file *os.File) {
+				_ = file.Close()
+			}(file)
+
+			var rows []closedDayRow
+			err := reader.Read(file, &rows)
+
+			if tt.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestWriter_BeforeWriteHook(t *testing.T) {
+	rows := []closedDayRow{
+		{
+			Begin:   time.Date(2025, 8, 9, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+			Summary: "夏季休業",
+		},
+	}
+
+	t.Run("BeforeWriteで日数が算出される", func(t *testing.T) {
+		writer := csvx.NewDefaultWriter()
+		writer.HasHeader = true
+		writer.RowHooks = []csvx.RowHook{
+			func(row interface{}) error {
+				r := row.(*closedDayRow)
+				r.Summary = "【全社】" + r.Summary
+				return nil
+			},
+		}
+
+		actual, err := writer.WriteString(rows)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "begin,days,summary\n2025-08-09,9,【全社】夏季休業\n", actual)
+		assert.Equal(t, 0, rows[0].Days)
+	})
+
+	t.Run("BeforeWriteのエラーが返される", func(t *testing.T) {
+		invalid := []closedDayRow{{Begin: rows[0].End, End: rows[0].Begin}}
+
+		_, err := csvx.NewDefaultWriter().WriteString(invalid)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,93 @@
+package csvx
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+	"golang.org/x/xerrors"
+)
+
+// Normalization is a set of text normalisations applied to field values before type conversion
+type Normalization uint
+
+// Predefined normalisations. They are applied in the order they are declared.
+const (
+	NormalizeNFKC      Normalization = 1 << iota // Unicode NFKC, e.g. "２０２５" to "2025" and "ﾔﾏﾀﾞ" to "ヤマダ"
+	NormalizeFold                                // Full-width alphanumerics and spaces to half-width and half-width kana to full-width, without composing voiced marks
+	NormalizeNarrow                              // Full-width characters, including the ideographic space, to half-width
+	NormalizeWiden                               // Half-width characters, including ASCII, to full-width
+	NormalizeDash                                // Hyphens, dashes and minus signs to "-", leaving the prolonged sound mark "ー" as is
+	NormalizeTrimSpace                           // Leading and trailing white space, including ideographic spaces
+)
+
+// normalizeOptions maps the option names of the normalize tag to normalisations
+var normalizeOptions = map[string]Normalization{
+	"nfkc":   NormalizeNFKC,
+	"fold":   NormalizeFold,
+	"narrow": NormalizeNarrow,
+	"widen":  NormalizeWiden,
+	"dash":   NormalizeDash,
+	"trim":   NormalizeTrimSpace,
+}
+
+// dashReplacer replaces dash-like characters commonly mixed up in hand-typed Japanese text
+var dashReplacer = strings.NewReplacer(
+	"‐", "-", // HYPHEN
+	"‑", "-", // NON-BREAKING HYPHEN
+	"‒", "-", // FIGURE DASH
+	"–", "-", // EN DASH
+	"—", "-", // EM DASH
+	"―", "-", // HORIZONTAL BAR
+	"⁃", "-", // HYPHEN BULLET
+	"−", "-", // MINUS SIGN
+	"﹣", "-", // SMALL HYPHEN-MINUS
+	"－", "-", // FULLWIDTH HYPHEN-MINUS
+)
+
+// parseNormalization parses a comma separated list of normalize tag options
+func parseNormalization(tag string) (Normalization, error) {
+	var n Normalization
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+
+		v, ok := normalizeOptions[option]
+		if !ok {
+			return 0, xerrors.Errorf("unknown normalize option: %s", option)
+		}
+		n |= v
+	}
+	return n, nil
+}
+
+// Apply applies the normalisations to the given value
+func (n Normalization) Apply(value string) string {
+	if n == 0 || value == "" {
+		return value
+	}
+
+	if n&NormalizeNFKC != 0 {
+		value = norm.NFKC.String(value)
+	}
+	if n&NormalizeFold != 0 {
+		value = width.Fold.String(value)
+	}
+	if n&NormalizeNarrow != 0 {
+		value = width.Narrow.String(value)
+	}
+	if n&NormalizeWiden != 0 {
+		value = width.Widen.String(value)
+	}
+	if n&NormalizeDash != 0 {
+		value = dashReplacer.Replace(value)
+	}
+	if n&NormalizeTrimSpace != 0 {
+		value = strings.TrimFunc(value, unicode.IsSpace)
+	}
+
+	return value
+}
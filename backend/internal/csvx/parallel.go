@@ -0,0 +1,146 @@
+package csvx
+
+import (
+	"io"
+	"reflect"
+	"sync"
+)
+
+// parallelBatchSize is the number of records handed to a worker at once
+const parallelBatchSize = 1024
+
+// decodeBatch is a chunk of consecutive records decoded by a single worker
+type decodeBatch struct {
+	seq     int             // Position of the batch in the input
+	records [][]string      // Raw records
+	values  []reflect.Value // Decoded struct values
+	err     error           // First read or decode error in the batch
+}
+
+// decode converts the records of the batch, stopping at the first error.
+// A decode error replaces a read error since it occurred on an earlier row.
+func (b *decodeBatch) decode(d *decoder) {
+	b.values = make([]reflect.Value, 0, len(b.records))
+	for _, record := range b.records {
+		v, err := d.decode(record)
+		if err != nil {
+			b.err = err
+			return
+		}
+		b.values = append(b.values, v)
+	}
+}
+
+// readParallel reads records sequentially and decodes them on several goroutines.
+// Batches are appended to the slice in input order, so the result is identical to serial decoding.
+// The producer and the workers have exited when it returns, so the caller's reader is no longer read.
+func readParallel(tok *tokenizer, sliceValue reflect.Value, d *decoder, workers int) error {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	jobs := make(chan *decodeBatch)
+	results := make(chan *decodeBatch)
+
+	// Bound the number of batches held in memory
+	inflight := make(chan struct{}, workers*2)
+
+	// Producer: the tokenizer is not safe for concurrent use, so records are read on a single goroutine
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		for seq := 0; ; seq++ {
+			batch := &decodeBatch{seq: seq, records: make([][]string, 0, parallelBatchSize)}
+			eof := false
+			for len(batch.records) < parallelBatchSize {
+				// Stop reading as soon as the collector has given up
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				record, err := tok.Read()
+				if err == io.EOF {
+					eof = true
+					break
+				}
+				if err != nil {
+					batch.err = err
+					break
+				}
+				batch.records = append(batch.records, record)
+			}
+
+			// The batch belongs to a worker once sent
+			last := eof || batch.err != nil
+
+			select {
+			case inflight <- struct{}{}:
+			case <-done:
+				return
+			}
+			select {
+			case jobs <- batch:
+			case <-done:
+				return
+			}
+
+			if last {
+				return
+			}
+		}
+	}()
+
+	// Workers
+	var decoders sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		decoders.Add(1)
+		go func() {
+			defer wg.Done()
+			defer decoders.Done()
+			for batch := range jobs {
+				batch.decode(d)
+				select {
+				case results <- batch:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		decoders.Wait()
+		close(results)
+	}()
+
+	// Collector: reassemble the batches in input order
+	pending := make(map[int]*decodeBatch)
+	next := 0
+	for batch := range results {
+		pending[batch.seq] = batch
+		for {
+			b, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			<-inflight
+
+			for _, v := range b.values {
+				sliceValue.Set(reflect.Append(sliceValue, v))
+			}
+			if b.err != nil {
+				return b.err
+			}
+		}
+	}
+
+	return nil
+}
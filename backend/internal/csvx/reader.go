@@ -1,10 +1,10 @@
 package csvx
 
 import (
-	"encoding/csv"
 	"fmt"
 	"io"
 	"reflect"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -17,10 +17,20 @@ import (
 
 // Reader provides functionality to read CSV data into structs
 type Reader struct {
-	Encoding  transform.Transformer // Character encoding transformer
-	Delimiter Delimiter             // Field delimiter
-	UseBOM    bool                  // UseBOM defines whether to use a BOM (Byte Order Mark) in the CSV encoding transformation.
-	HasHeader bool                  // Whether CSV has a header row
+	Encoding         transform.Transformer // Character encoding transformer
+	Delimiter        Delimiter             // Field delimiter
+	Separator        string                // Multi-character field separator, takes precedence over Delimiter when set
+	Quote            rune                  // Quote character, '"' when zero
+	Escape           rune                  // Escape character inside quoted fields, zero means quotes are escaped by doubling
+	Comment          string                // Lines starting with this prefix are skipped
+	SkipRows         int                   // Number of leading lines skipped before the header, e.g. title rows
+	LazyQuotes       bool                  // Allow quotes in unquoted fields and unescaped quotes in quoted fields
+	TrimLeadingSpace bool                  // Ignore leading white space in a field
+	UseBOM           bool                  // UseBOM defines whether to use a BOM (Byte Order Mark) in the CSV encoding transformation.
+	HasHeader        bool                  // Whether CSV has a header row
+	Workers          int                   // Number of goroutines decoding records, serial when 1 or less and GOMAXPROCS when negative
+	RowHooks         []RowHook             // Functions applied to every row after its AfterRead method
+	Normalize        Normalization         // Normalisations applied to header names and to fields without a normalize tag
 }
 
 // NewDefaultReader creates a new Reader with default configuration
@@ -28,6 +38,7 @@ func NewDefaultReader() *Reader {
 	return &Reader{
 		Encoding:  unicode.UTF8.NewDecoder(),
 		Delimiter: DelimiterComma,
+		Quote:     '"',
 		UseBOM:    false,
 		HasHeader: true,
 	}
@@ -57,9 +68,16 @@ func (r *Reader) Read(reader io.Reader, dest interface{}) error {
 		transformedReader = transform.NewReader(transformedReader, unicode.BOMOverride(r.Encoding))
 	}
 
-	// Create a CSV reader
-	csvReader := csv.NewReader(transformedReader)
-	csvReader.Comma = rune(r.Delimiter)
+	// Create a CSV tokenizer
+	csvReader := newTokenizer(transformedReader, r)
+
+	// Skip leading title rows
+	if err := csvReader.skip(r.SkipRows); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
 
 	// Map of header indices
 	headerIndices := make(map[string]int)
@@ -72,17 +90,18 @@ func (r *Reader) Read(reader io.Reader, dest interface{}) error {
 			return err
 		}
 
-		// Create a map of header indices
+		// Map the normalised header names to their columns
+		columns := make(map[string]int, len(headers))
 		for i, header := range headers {
-			headerIndices[header] = i
+			columns[r.Normalize.Apply(header)] = i
 		}
 
-		// Check for required fields
+		// Look up the tag names with the same normalisation and check for required fields
 		for _, field := range fields {
-			if field.required {
-				if _, ok := headerIndices[field.header]; !ok {
-					return xerrors.Errorf("required field is missing: %s", field.header)
-				}
+			if i, ok := columns[r.Normalize.Apply(field.header)]; ok {
+				headerIndices[field.header] = i
+			} else if field.required {
+				return xerrors.Errorf("required field is missing: %s", field.header)
 			}
 		}
 	} else {
@@ -94,6 +113,19 @@ func (r *Reader) Read(reader io.Reader, dest interface{}) error {
 		}
 	}
 
+	d := &decoder{
+		elemType:      elemType,
+		fields:        fields,
+		headerIndices: headerIndices,
+		hooks:         r.RowHooks,
+		normalize:     r.Normalize,
+	}
+
+	// Decode the rows in parallel if configured
+	if workers := r.workers(); workers > 1 {
+		return readParallel(csvReader, sliceValue, d, workers)
+	}
+
 	// Read and process each row
 	for {
 		record, err := csvReader.Read()
@@ -104,49 +136,87 @@ func (r *Reader) Read(reader io.Reader, dest interface{}) error {
 			return err
 		}
 
-		// Create a new instance of the struct
-		newElem := reflect.New(elemType).Elem()
+		newElem, err := d.decode(record)
+		if err != nil {
+			return err
+		}
 
-		// Fill the struct fields
-		for _, field := range fields {
-			if field.ignored {
-				continue
-			}
+		// Append the new element to the slice
+		sliceValue.Set(reflect.Append(sliceValue, newElem))
+	}
 
-			fieldValue := newElem.FieldByName(field.name)
-			if !fieldValue.CanSet() {
-				continue
-			}
+	return nil
+}
 
-			// Get the value from the CSV record
-			var strValue string
-			if idx, ok := headerIndices[field.header]; ok && idx < len(record) {
-				strValue = record[idx]
-				// Apply the default value if the field is empty and has a default value
-				if strValue == "" && field.defaultValue != "" {
-					strValue = field.defaultValue
-				}
-			} else if field.defaultValue != "" {
-				// Use default value if header not found but default is provided
-				strValue = field.defaultValue
-			} else if field.required {
-				return xerrors.Errorf("required field is missing: %s", field.header)
+// workers returns the number of goroutines used for decoding records
+func (r *Reader) workers() int {
+	if r.Workers < 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return r.Workers
+}
+
+// decoder converts CSV records into struct values
+type decoder struct {
+	elemType      reflect.Type   // Type of the destination struct
+	fields        []fieldInfo    // Parsed struct fields
+	headerIndices map[string]int // Column index for each header
+	hooks         []RowHook      // Row hooks of the reader
+	normalize     Normalization  // Normalisations of the reader
+}
+
+// decode creates a new struct value from the given record
+func (d *decoder) decode(record []string) (reflect.Value, error) {
+	// Create a new instance of the struct
+	newElem := reflect.New(d.elemType).Elem()
+
+	// Fill the struct fields
+	for _, field := range d.fields {
+		if field.ignored {
+			continue
+		}
+
+		fieldValue := newElem.FieldByName(field.name)
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		// Get the value from the CSV record
+		var strValue string
+		if idx, ok := d.headerIndices[field.header]; ok && idx < len(record) {
+			strValue = record[idx]
+			// Normalise the value before applying the default value and converting it
+			if field.normalize != nil {
+				strValue = field.normalize.Apply(strValue)
 			} else {
-				// Skip this field
-				continue
+				strValue = d.normalize.Apply(strValue)
 			}
-
-			// Convert the string value to the appropriate type
-			if err := setFieldValue(fieldValue, strValue, field.format); err != nil {
-				return fmt.Errorf("error setting field %s: %w", field.name, err)
+			// Apply the default value if the field is empty and has a default value
+			if strValue == "" && field.defaultValue != "" {
+				strValue = field.defaultValue
 			}
+		} else if field.defaultValue != "" {
+			// Use default value if header not found but default is provided
+			strValue = field.defaultValue
+		} else if field.required {
+			return reflect.Value{}, xerrors.Errorf("required field is missing: %s", field.header)
+		} else {
+			// Skip this field
+			continue
 		}
 
-		// Append the new element to the slice
-		sliceValue.Set(reflect.Append(sliceValue, newElem))
+		// Convert the string value to the appropriate type
+		if err := setFieldValue(fieldValue, strValue, field.format); err != nil {
+			return reflect.Value{}, fmt.Errorf("error setting field %s: %w", field.name, err)
+		}
 	}
 
-	return nil
+	// Post-process the row
+	if err := afterRead(newElem, d.hooks); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return newElem, nil
 }
 
 // setFieldValue converts a string value to the appropriate type and sets it on the given field
@@ -1,7 +1,11 @@
 package csvx_test
 
 import (
+	"fmt"
+	"io"
 	"os"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -472,3 +476,387 @@ func TestReader_ParsingToVariousDatetimeFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestReader_CustomTokenizerOptions(t *testing.T) {
+	type person struct {
+		Name string `csv:"name"`
+		Age  int    `csv:"age"`
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		reader   csvx.Reader
+		expected interface{}
+	}{
+		{
+			name:     "タイトル行の読み飛ばし",
+			filePath: "./testdata/skip_rows.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, SkipRows: 1},
+			expected: []person{
+				{Name: "山田　太郎", Age: 20},
+				{Name: "小島　直樹", Age: 30},
+			},
+		},
+		{
+			name:     "シングルクォートで囲まれたフィールド",
+			filePath: "./testdata/quote_single.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, Quote: '\''},
+			expected: []person{
+				{Name: "Yamada, taro", Age: 20},
+				{Name: "Kojima 'naoki'", Age: 30},
+			},
+		},
+		{
+			name:     "バックスラッシュによるエスケープ",
+			filePath: "./testdata/escape_backslash.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, Escape: '\\'},
+			expected: []person{
+				{Name: `Yamada "taro"`, Age: 20},
+				{Name: "Kojima, naoki", Age: 30},
+			},
+		},
+		{
+			name:     "コメント行の読み飛ばし",
+			filePath: "./testdata/comment.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, Comment: "#"},
+			expected: []person{
+				{Name: "Yamada taro", Age: 20},
+				{Name: "Kojima naoki", Age: 30},
+			},
+		},
+		{
+			name:     "複数文字の区切り文字",
+			filePath: "./testdata/delimiter_multi.csv",
+			reader:   csvx.Reader{Separator: "||"},
+			expected: []person{
+				{Name: "Yamada taro", Age: 20},
+				{Name: "Kojima naoki", Age: 30},
+			},
+		},
+		{
+			name:     "先頭の空白の除去",
+			filePath: "./testdata/leading_space.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, TrimLeadingSpace: true},
+			expected: []person{
+				{Name: "Yamada taro", Age: 20},
+				{Name: "Kojima naoki", Age: 30},
+			},
+		},
+		{
+			name:     "クォートの緩い解釈",
+			filePath: "./testdata/lazy_quotes.csv",
+			reader:   csvx.Reader{Delimiter: csvx.DelimiterComma, LazyQuotes: true},
+			expected: []person{
+				{Name: `Yamada "taro"`, Age: 20},
+				{Name: `Kojima "naoki" san`, Age: 30},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := tt.reader
+			reader.Encoding = unicode.UTF8.NewDecoder()
+			reader.HasHeader = true
+
+			file, _ := os.Open(tt.filePath)
+			defer func(file *os.File) {
+				_ = file.Close()
+			}(file)
+
+			var p []person
+			err := reader.Read(file, &p)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, p)
+		})
+	}
+}
+
+func TestReader_QuoteErrors(t *testing.T) {
+	type person struct {
+		Name string `csv:"name"`
+		Age  int    `csv:"age"`
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected error
+	}{
+		{
+			name:     "クォートされていないフィールド内のクォート",
+			data:     "name,age\nYamada \"taro\",20\n",
+			expected: csvx.ErrBareQuote,
+		},
+		{
+			name:     "閉じられていないクォート",
+			data:     "name,age\n\"Yamada taro,20\n",
+			expected: csvx.ErrQuote,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := csvx.NewDefaultReader()
+
+			var p []person
+			err := reader.ReadString(tt.data, &p)
+
+			var parseErr *csvx.ParseError
+			assert.ErrorAs(t, err, &parseErr)
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, 2, parseErr.Line)
+		})
+	}
+}
+
+func TestReader_FieldCountErrors(t *testing.T) {
+	type person struct {
+		Name string `csv:"name"`
+		Age  int    `csv:"age"`
+	}
+
+	tests := []struct {
+		name string
+		data string
+		line int
+	}{
+		{
+			name: "フィールドが足りない行",
+			data: "name,age\nYamada taro,20\nSato hanako\n",
+			line: 3,
+		},
+		{
+			name: "フィールドが多すぎる行",
+			data: "name,age\nYamada taro,20,extra\n",
+			line: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := csvx.NewDefaultReader()
+
+			var p []person
+			err := reader.ReadString(tt.data, &p)
+
+			var parseErr *csvx.ParseError
+			assert.ErrorAs(t, err, &parseErr)
+			assert.ErrorIs(t, err, csvx.ErrFieldCount)
+			assert.Equal(t, tt.line, parseErr.Line)
+		})
+	}
+}
+
+func TestReader_ParallelDecoding(t *testing.T) {
+	type storeDay struct {
+		StoreID int       `csv:"store_id,required"`
+		Date    time.Time `csv:"date" format:"2006-01-02"`
+		Open    bool      `csv:"open"`
+		Note    string    `csv:"note" default:"なし"`
+	}
+
+	var b strings.Builder
+	b.WriteString("store_id,date,open,note\n")
+	begin := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10000; i++ {
+		note := ""
+		if i%3 == 0 {
+			note = "棚卸し"
+		}
+		fmt.Fprintf(&b, "%d,%s,%d,%s\n", i%50, begin.AddDate(0, 0, i%365).Format("2006-01-02"), i%2, note)
+	}
+	data := b.String()
+
+	t.Run("並列でデコードしても行の順序が保たれる", func(t *testing.T) {
+		serial := csvx.NewDefaultReader()
+		var expected []storeDay
+		err := serial.ReadString(data, &expected)
+		assert.NoError(t, err)
+
+		parallel := csvx.NewDefaultReader()
+		parallel.Workers = 4
+		var actual []storeDay
+		err = parallel.ReadString(data, &actual)
+		assert.NoError(t, err)
+
+		assert.Equal(t, 10000, len(actual))
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("最初に発生したエラーが返される", func(t *testing.T) {
+		broken := strings.Replace(data, "\n7,", "\nx,", 1)
+		broken = strings.Replace(broken, "\n9999,", "\n9999,2025-13-01,", 1)
+
+		serial := csvx.NewDefaultReader()
+		var expected []storeDay
+		expectedErr := serial.ReadString(broken, &expected)
+
+		parallel := csvx.NewDefaultReader()
+		parallel.Workers = 4
+		var actual []storeDay
+		actualErr := parallel.ReadString(broken, &actual)
+
+		assert.Error(t, actualErr)
+		assert.Equal(t, expectedErr.Error(), actualErr.Error())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("エラーで戻った後に入力が読まれない", func(t *testing.T) {
+		broken := strings.Replace(data, "\n7,", "\nx,", 1)
+		input := &closableReader{r: strings.NewReader(broken)}
+
+		parallel := csvx.NewDefaultReader()
+		parallel.Workers = 4
+		var actual []storeDay
+		err := parallel.Read(input, &actual)
+		input.Close()
+
+		assert.Error(t, err)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, int32(0), input.readsAfterClose.Load())
+	})
+}
+
+// closableReader counts the reads made after it is closed.
+// Reads are slowed down so that a producer still running after Read returns is caught.
+type closableReader struct {
+	r               io.Reader
+	closed          atomic.Bool
+	readsAfterClose atomic.Int32
+}
+
+func (c *closableReader) Read(p []byte) (int, error) {
+	if c.closed.Load() {
+		c.readsAfterClose.Add(1)
+	}
+	time.Sleep(100 * time.Microsecond)
+	if len(p) > 64 {
+		p = p[:64]
+	}
+	return c.r.Read(p)
+}
+
+func (c *closableReader) Close() {
+	c.closed.Store(true)
+}
+
+func TestReader_Normalization(t *testing.T) {
+	type person struct {
+		Name     string    `csv:"名前"`
+		Age      int       `csv:"年齢"`
+		JoinedAt time.Time `csv:"入社日" format:"2006/1/2"`
+		Phone    string    `csv:"電話"`
+		Note     string    `csv:"備考" normalize:"-"`
+	}
+
+	type foldedPerson struct {
+		Name string `csv:"名前" normalize:"nfkc,trim"`
+		Age  int    `csv:"年齢" normalize:"nfkc,trim"`
+		Note string `csv:"備考" normalize:"fold"`
+	}
+
+	tests := []struct {
+		name      string
+		normalize csvx.Normalization
+		actual    func(r csvx.Reader, file *os.File) (interface{}, error)
+		expected  interface{}
+	}{
+		{
+			name:      "Readerの正規化が型変換の前に適用される",
+			normalize: csvx.NormalizeNFKC | csvx.NormalizeDash | csvx.NormalizeTrimSpace,
+			actual: func(r csvx.Reader, file *os.File) (interface{}, error) {
+				var p []person
+				err := r.Read(file, &p)
+				return p, err
+			},
+			expected: []person{
+				{
+					Name:     "ヤマダ タロウ",
+					Age:      20,
+					JoinedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					Phone:    "03-1234-5678",
+					Note:     "　ＡＢＣ　",
+				},
+				{
+					Name:     "小島 直樹",
+					Age:      30,
+					JoinedAt: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+					Phone:    "03-1234-5678",
+					Note:     "ＸＹＺ",
+				},
+			},
+		},
+		{
+			name: "フィールドごとの正規化が適用される",
+			actual: func(r csvx.Reader, file *os.File) (interface{}, error) {
+				var p []foldedPerson
+				err := r.Read(file, &p)
+				return p, err
+			},
+			expected: []foldedPerson{
+				{Name: "ヤマダ タロウ", Age: 20, Note: " ABC "},
+				{Name: "小島 直樹", Age: 30, Note: "XYZ"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := csvx.Reader{
+				Encoding:  unicode.UTF8.NewDecoder(),
+				Delimiter: csvx.DelimiterComma,
+				HasHeader: true,
+				Normalize: tt.normalize,
+			}
+
+			file, _ := os.Open("./testdata/normalize.csv")
+			defer func(file *os.File) {
+				_ = file.Close()
+			}(file)
+
+			actual, err := tt.actual(reader, file)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestReader_NormalizedHeaderNames(t *testing.T) {
+	type person struct {
+		Name string `csv:"ｎａｍｅ,required"`
+		Age  int    `csv:"age"`
+	}
+
+	reader := csvx.NewDefaultReader()
+	reader.Normalize = csvx.NormalizeNFKC | csvx.NormalizeTrimSpace
+
+	t.Run("全角と半角だけが異なるヘッダーとタグが対応する", func(t *testing.T) {
+		var p []person
+		err := reader.ReadString("name,ａｇｅ　\nYamada taro,20\n", &p)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []person{{Name: "Yamada taro", Age: 20}}, p)
+	})
+
+	t.Run("正規化しても一致しない必須フィールドはエラーになる", func(t *testing.T) {
+		var p []person
+		err := reader.ReadString("氏名,age\nYamada taro,20\n", &p)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestReader_UnknownNormalizeOption(t *testing.T) {
+	type person struct {
+		Name string `csv:"name" normalize:"nfkc,unknown"`
+	}
+
+	var p []person
+	err := csvx.NewDefaultReader().ReadString("name\nYamada taro\n", &p)
+
+	assert.Error(t, err)
+}
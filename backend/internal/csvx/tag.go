@@ -8,13 +8,14 @@ import (
 )
 
 type fieldInfo struct {
-	name         string       // Field name in the struct
-	header       string       // CSV header name
-	required     bool         // Whether the field is required
-	ignored      bool         // Whether the field should be ignored
-	defaultValue string       // defaultValue value for the field
-	format       string       // format string for date/time fields
-	fieldType    reflect.Type // The type of the field
+	name         string         // Field name in the struct
+	header       string         // CSV header name
+	required     bool           // Whether the field is required
+	ignored      bool           // Whether the field should be ignored
+	defaultValue string         // defaultValue value for the field
+	format       string         // format string for date/time fields
+	fieldType    reflect.Type   // The type of the field
+	normalize    *Normalization // Normalisations of the field, nil to use those of the reader
 }
 
 func parseStructTags(t reflect.Type) ([]fieldInfo, error) {
@@ -76,6 +77,19 @@ func parseStructTags(t reflect.Type) ([]fieldInfo, error) {
 			info.format = formatTag
 		}
 
+		// Parse normalize tag
+		if normalizeTag, ok := field.Tag.Lookup("normalize"); ok {
+			var n Normalization
+			if normalizeTag != "-" {
+				parsed, err := parseNormalization(normalizeTag)
+				if err != nil {
+					return nil, xerrors.Errorf("invalid normalize tag on field %s: %w", field.Name, err)
+				}
+				n = parsed
+			}
+			info.normalize = &n
+		}
+
 		fields = append(fields, info)
 	}
 
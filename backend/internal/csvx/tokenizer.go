@@ -0,0 +1,235 @@
+package csvx
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Errors reported by the tokenizer
+var (
+	ErrBareQuote  = errors.New("bare quote in non-quoted field")
+	ErrQuote      = errors.New("extraneous or missing quote in quoted field")
+	ErrFieldCount = errors.New("wrong number of fields")
+)
+
+// ParseError is returned for parsing errors. Line and column numbers are 1-indexed.
+type ParseError struct {
+	Line   int   // Line where the error occurred
+	Column int   // Column (rune index) where the error occurred
+	Err    error // The actual error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error on line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// tokenizer splits CSV input into records.
+// Unlike encoding/csv it supports configurable quote and escape characters,
+// multi-character separators and comment prefixes.
+type tokenizer struct {
+	r                *bufio.Reader
+	separator        string // Field separator, possibly longer than one character
+	quote            rune   // Quote character
+	escape           rune   // Escape character inside quoted fields, zero for doubled quotes
+	comment          string // Lines starting with this prefix are skipped
+	lazyQuotes       bool   // Whether quotes may appear in unquoted fields and unescaped in quoted fields
+	trimLeadingSpace bool   // Whether leading white space in a field is ignored
+	line             int    // Number of the last line read
+	recordLine       int    // Number of the line the last record started on
+	fieldsPerRecord  int    // Number of fields of the first record, which every record must have
+}
+
+func newTokenizer(r io.Reader, cfg *Reader) *tokenizer {
+	separator := cfg.Separator
+	if separator == "" {
+		separator = string(rune(cfg.Delimiter))
+	}
+
+	quote := cfg.Quote
+	if quote == 0 {
+		quote = '"'
+	}
+
+	return &tokenizer{
+		r:                bufio.NewReader(r),
+		separator:        separator,
+		quote:            quote,
+		escape:           cfg.Escape,
+		comment:          cfg.Comment,
+		lazyQuotes:       cfg.LazyQuotes,
+		trimLeadingSpace: cfg.TrimLeadingSpace,
+	}
+}
+
+// readLine reads the next physical line without its line terminator.
+// io.EOF is returned only when no more data is available.
+func (t *tokenizer) readLine() (string, error) {
+	line, err := t.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+	t.line++
+
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return line, nil
+}
+
+// skip discards the given number of physical lines.
+func (t *tokenizer) skip(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := t.readLine(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read reads the next record. Empty lines and comment lines are skipped.
+// Every record must have as many fields as the first one, as with encoding/csv.
+func (t *tokenizer) Read() ([]string, error) {
+	record, err := t.readRecord()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.fieldsPerRecord == 0 {
+		t.fieldsPerRecord = len(record)
+	} else if len(record) != t.fieldsPerRecord {
+		return nil, &ParseError{Line: t.recordLine, Column: 1, Err: ErrFieldCount}
+	}
+	return record, nil
+}
+
+// readRecord reads the fields of the next record.
+func (t *tokenizer) readRecord() ([]string, error) {
+	var line string
+	for {
+		l, err := t.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if l == "" {
+			continue
+		}
+		if t.comment != "" && strings.HasPrefix(l, t.comment) {
+			continue
+		}
+		line = l
+		t.recordLine = t.line
+		break
+	}
+
+	var record []string
+	pos := 0
+	for {
+		if t.trimLeadingSpace {
+			pos += len(line[pos:]) - len(strings.TrimLeftFunc(line[pos:], unicode.IsSpace))
+		}
+
+		var field string
+		var err error
+		if strings.HasPrefix(line[pos:], string(t.quote)) {
+			field, line, pos, err = t.readQuoted(line, pos+utf8.RuneLen(t.quote))
+		} else {
+			field, pos, err = t.readUnquoted(line, pos)
+		}
+		if err != nil {
+			return nil, err
+		}
+		record = append(record, field)
+
+		if !strings.HasPrefix(line[pos:], t.separator) {
+			return record, nil
+		}
+		pos += len(t.separator)
+	}
+}
+
+// readUnquoted reads a field starting at pos that is not enclosed in quotes.
+func (t *tokenizer) readUnquoted(line string, pos int) (string, int, error) {
+	end := len(line)
+	if i := strings.Index(line[pos:], t.separator); i >= 0 {
+		end = pos + i
+	}
+
+	field := line[pos:end]
+	if !t.lazyQuotes {
+		if i := strings.IndexRune(field, t.quote); i >= 0 {
+			return "", 0, t.error(line, pos+i, ErrBareQuote)
+		}
+	}
+	return field, end, nil
+}
+
+// readQuoted reads a quoted field whose content starts at pos.
+// Quoted fields may span several physical lines, so the current line is returned along with the position.
+func (t *tokenizer) readQuoted(line string, pos int) (string, string, int, error) {
+	var b strings.Builder
+	for {
+		r, size := utf8.DecodeRuneInString(line[pos:])
+		switch {
+		case pos >= len(line):
+			// The field continues on the next line
+			next, err := t.readLine()
+			if err == io.EOF {
+				if t.lazyQuotes {
+					return b.String(), line, pos, nil
+				}
+				return "", "", 0, t.error(line, pos, ErrQuote)
+			}
+			if err != nil {
+				return "", "", 0, err
+			}
+			b.WriteByte('\n')
+			line, pos = next, 0
+		case t.escape != 0 && t.escape != t.quote && r == t.escape:
+			pos += size
+			if pos >= len(line) {
+				// An escaped line break
+				continue
+			}
+			escaped, n := utf8.DecodeRuneInString(line[pos:])
+			b.WriteRune(escaped)
+			pos += n
+		case r == t.quote:
+			pos += size
+			if strings.HasPrefix(line[pos:], string(t.quote)) {
+				// A doubled quote
+				b.WriteRune(t.quote)
+				pos += size
+				continue
+			}
+			if pos >= len(line) || strings.HasPrefix(line[pos:], t.separator) {
+				return b.String(), line, pos, nil
+			}
+			if !t.lazyQuotes {
+				return "", "", 0, t.error(line, pos, ErrQuote)
+			}
+			b.WriteRune(t.quote)
+		default:
+			b.WriteRune(r)
+			pos += size
+		}
+	}
+}
+
+func (t *tokenizer) error(line string, pos int, err error) error {
+	return &ParseError{
+		Line:   t.line,
+		Column: utf8.RuneCountInString(line[:pos]) + 1,
+		Err:    err,
+	}
+}
@@ -22,6 +22,7 @@ type Writer struct {
 	Delimiter Delimiter             // Field delimiter
 	UseCRLF   bool                  // True to use \r\n as the line terminator
 	HasHeader bool                  // Whether CSV has a header row
+	RowHooks  []RowHook             // Functions applied to every row after its BeforeWrite method
 }
 
 // NewDefaultWriter creates a new Writer with default configuration
@@ -46,8 +47,8 @@ func (w *Writer) Write(writer io.Writer, data interface{}) error {
 		return fmt.Errorf("data must be a slice, got %T", data)
 	}
 
-	// If the slice is empty, return early
-	if dataValue.Len() == 0 {
+	// An empty slice is only written as its header row
+	if dataValue.Len() == 0 && !w.HasHeader {
 		return xerrors.Errorf("empty CSV data")
 	}
 
@@ -87,6 +88,12 @@ func (w *Writer) Write(writer io.Writer, data interface{}) error {
 			rowValue = rowValue.Elem()
 		}
 
+		// Pre-process the row
+		rowValue, err = beforeWrite(rowValue, w.RowHooks)
+		if err != nil {
+			return err
+		}
+
 		// Create a row with values for each field
 		row := make([]string, 0, len(headers))
 
@@ -0,0 +1,26 @@
+package csvx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/csvx"
+)
+
+func TestWriter_Empty(t *testing.T) {
+	t.Run("空のデータはヘッダー行だけになる", func(t *testing.T) {
+		writer := csvx.NewDefaultWriter()
+		writer.HasHeader = true
+
+		actual, err := writer.WriteString([]closedDayRow{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "begin,days,summary\n", actual)
+	})
+
+	t.Run("ヘッダーのない空のデータはエラーになる", func(t *testing.T) {
+		_, err := csvx.NewDefaultWriter().WriteString([]closedDayRow{})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,83 @@
+package icalx
+
+import "time"
+
+// Formats of DATE and DATE-TIME values
+const (
+	dateFormat        = "20060102"
+	dateTimeFormat    = "20060102T150405"
+	utcDateTimeFormat = "20060102T150405Z"
+)
+
+// Calendar is an iCalendar object (VCALENDAR)
+type Calendar struct {
+	ProdID string  // Identifier of the product that created the calendar
+	Name   string  // Display name of the calendar (X-WR-CALNAME)
+	Events []Event // Events of the calendar
+}
+
+// Event is a calendar component (VEVENT)
+type Event struct {
+	UID         string      // Globally unique and stable identifier of the event
+	DTStamp     time.Time   // Time the event was created or generated
+	Start       time.Time   // Start of the event, a date for all-day events
+	End         time.Time   // Exclusive end of the event, the day after the last day for all-day events
+	AllDay      bool        // Whether Start and End are dates
+	Summary     string      // Title of the event
+	Description string      // Description of the event
+	Categories  []string    // Categories of the event
+	RRule       string      // Recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=SA"
+	ExDates     []time.Time // Start of excluded recurrences
+}
+
+// Duration returns the length of each occurrence. All-day events without an end last one day.
+func (e Event) Duration() time.Duration {
+	if e.End.IsZero() || !e.End.After(e.Start) {
+		if e.AllDay {
+			return 24 * time.Hour
+		}
+		return 0
+	}
+	return e.End.Sub(e.Start)
+}
+
+// Occurrences returns the start of every occurrence up to and including the horizon,
+// expanding the recurrence rule and removing excluded dates
+func (e Event) Occurrences(horizon time.Time) ([]time.Time, error) {
+	var starts []time.Time
+	if e.RRule == "" {
+		if !e.Start.After(horizon) {
+			starts = []time.Time{e.Start}
+		}
+	} else {
+		recur, err := ParseRecur(e.RRule)
+		if err != nil {
+			return nil, err
+		}
+		starts = recur.Expand(e.Start, horizon)
+	}
+
+	occurrences := make([]time.Time, 0, len(starts))
+	for _, s := range starts {
+		if !e.excluded(s) {
+			occurrences = append(occurrences, s)
+		}
+	}
+	return occurrences, nil
+}
+
+// excluded reports whether the occurrence starting at t is listed in EXDATE
+func (e Event) excluded(t time.Time) bool {
+	for _, exDate := range e.ExDates {
+		if e.AllDay {
+			y1, m1, d1 := exDate.Date()
+			y2, m2, d2 := t.Date()
+			if y1 == y2 && m1 == m2 && d1 == d2 {
+				return true
+			}
+		} else if exDate.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
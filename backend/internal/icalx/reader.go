@@ -0,0 +1,289 @@
+package icalx
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// textUnescaper unescapes TEXT property values (RFC 5545, 3.3.11)
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, `;`,
+	`\,`, `,`,
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+// durationPattern matches the dur-value of RFC 5545, 3.3.6
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// property is a parsed content line
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads an iCalendar object. Components other than VEVENT are skipped.
+// Floating times and dates are interpreted in time.Local.
+func Parse(r io.Reader) (*Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := &Calendar{}
+	var event *Event
+	var duration string
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, xerrors.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			event = &Event{}
+			duration = ""
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT"):
+			if event == nil {
+				return nil, xerrors.Errorf("line %d: END:VEVENT without BEGIN:VEVENT", i+1)
+			}
+			if event.End.IsZero() && duration != "" {
+				d, err := parseDuration(duration)
+				if err != nil {
+					return nil, xerrors.Errorf("event %s: %w", event.UID, err)
+				}
+				event.End = event.Start.Add(d)
+			}
+			cal.Events = append(cal.Events, *event)
+			event = nil
+		case event != nil:
+			if err := event.set(p, &duration); err != nil {
+				return nil, xerrors.Errorf("line %d: %w", i+1, err)
+			}
+		case p.name == "PRODID":
+			cal.ProdID = p.value
+		case p.name == "X-WR-CALNAME":
+			cal.Name = unescapeText(p.value)
+		}
+	}
+
+	if event != nil {
+		return nil, xerrors.Errorf("unterminated VEVENT: %s", event.UID)
+	}
+	return cal, nil
+}
+
+// set applies a property to the event
+func (e *Event) set(p property, duration *string) error {
+	switch p.name {
+	case "UID":
+		e.UID = p.value
+	case "DTSTAMP":
+		t, _, err := parseTime(p.value, p.params)
+		if err != nil {
+			return err
+		}
+		e.DTStamp = t
+	case "DTSTART":
+		t, allDay, err := parseTime(p.value, p.params)
+		if err != nil {
+			return err
+		}
+		e.Start = t
+		e.AllDay = allDay
+	case "DTEND":
+		t, _, err := parseTime(p.value, p.params)
+		if err != nil {
+			return err
+		}
+		e.End = t
+	case "DURATION":
+		*duration = p.value
+	case "SUMMARY":
+		e.Summary = unescapeText(p.value)
+	case "DESCRIPTION":
+		e.Description = unescapeText(p.value)
+	case "CATEGORIES":
+		for _, c := range splitList(p.value) {
+			e.Categories = append(e.Categories, unescapeText(c))
+		}
+	case "RRULE":
+		e.RRule = p.value
+	case "EXDATE":
+		for _, v := range strings.Split(p.value, ",") {
+			t, _, err := parseTime(v, p.params)
+			if err != nil {
+				return err
+			}
+			e.ExDates = append(e.ExDates, t)
+		}
+	}
+	return nil
+}
+
+// unfold joins folded content lines. Continuation lines start with a space or a tab.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// parseProperty splits a content line into its name, parameters and value.
+// Parameter values may be quoted, in which case they can contain ':' and ';'.
+func parseProperty(line string) (property, error) {
+	p := property{params: map[string]string{}}
+
+	nameEnd := strings.IndexAny(line, ";:")
+	if nameEnd < 0 {
+		return property{}, xerrors.Errorf("invalid content line: %s", line)
+	}
+	p.name = strings.ToUpper(line[:nameEnd])
+
+	pos := nameEnd
+	for line[pos] == ';' {
+		pos++
+		eq := strings.IndexByte(line[pos:], '=')
+		if eq < 0 {
+			return property{}, xerrors.Errorf("invalid parameter: %s", line)
+		}
+		name := strings.ToUpper(line[pos : pos+eq])
+		pos += eq + 1
+
+		var value string
+		if strings.HasPrefix(line[pos:], `"`) {
+			end := strings.IndexByte(line[pos+1:], '"')
+			if end < 0 {
+				return property{}, xerrors.Errorf("unterminated quoted parameter: %s", line)
+			}
+			value = line[pos+1 : pos+1+end]
+			pos += end + 2
+		} else {
+			end := strings.IndexAny(line[pos:], ";:")
+			if end < 0 {
+				return property{}, xerrors.Errorf("invalid parameter: %s", line)
+			}
+			value = line[pos : pos+end]
+			pos += end
+		}
+		p.params[name] = value
+
+		if pos >= len(line) {
+			return property{}, xerrors.Errorf("property has no value: %s", line)
+		}
+	}
+
+	if line[pos] != ':' {
+		return property{}, xerrors.Errorf("invalid content line: %s", line)
+	}
+	p.value = line[pos+1:]
+	return p, nil
+}
+
+// parseTime parses a DATE or DATE-TIME value and reports whether it was a DATE
+func parseTime(value string, params map[string]string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateFormat) {
+		t, err := time.ParseInLocation(dateFormat, value, time.Local)
+		if err != nil {
+			return time.Time{}, false, xerrors.Errorf("invalid date: %s", value)
+		}
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcDateTimeFormat, value)
+		if err != nil {
+			return time.Time{}, false, xerrors.Errorf("invalid date-time: %s", value)
+		}
+		return t, false, nil
+	}
+
+	loc := time.Local
+	if tzid := params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, xerrors.Errorf("unknown time zone: %s", tzid)
+		}
+		loc = l
+	}
+
+	t, err := time.ParseInLocation(dateTimeFormat, value, loc)
+	if err != nil {
+		return time.Time{}, false, xerrors.Errorf("invalid date-time: %s", value)
+	}
+	return t, false, nil
+}
+
+// parseDuration parses a dur-value such as "P3D" or "PT1H30M"
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || value == "PT" {
+		return 0, xerrors.Errorf("invalid duration: %s", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, xerrors.Errorf("invalid duration: %s", value)
+		}
+		d += time.Duration(n) * unit
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// splitList splits a comma separated TEXT list, keeping escaped commas
+func splitList(value string) []string {
+	var items []string
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			b.WriteByte(value[i])
+			b.WriteByte(value[i+1])
+			i++
+		case value[i] == ',':
+			items = append(items, b.String())
+			b.Reset()
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return append(items, b.String())
+}
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
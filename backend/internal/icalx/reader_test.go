@@ -0,0 +1,136 @@
+package icalx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/icalx"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("終日イベントと時刻付きイベントが読み込まれる", func(t *testing.T) {
+		input := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//example//hr//JA",
+			"X-WR-CALNAME:休業日",
+			"BEGIN:VTIMEZONE",
+			"TZID:Asia/Tokyo",
+			"END:VTIMEZONE",
+			"BEGIN:VEVENT",
+			"UID:new-year@example.com",
+			"DTSTART;VALUE=DATE:20251229",
+			"DTEND;VALUE=DATE:20260104",
+			"SUMMARY:年末年始休業\\, 全社",
+			"CATEGORIES:closed,company",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:inventory@example.com",
+			`DTSTART;TZID="Asia/Tokyo":20250801T090000`,
+			"DURATION:PT3H",
+			"SUMMARY:棚卸",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n")
+
+		actual, err := icalx.Parse(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "-//example//hr//JA", actual.ProdID)
+		assert.Equal(t, "休業日", actual.Name)
+		assert.Equal(t, 2, len(actual.Events))
+
+		allDay := actual.Events[0]
+		assert.True(t, allDay.AllDay)
+		assert.Equal(t, "年末年始休業, 全社", allDay.Summary)
+		assert.Equal(t, []string{"closed", "company"}, allDay.Categories)
+		assert.Equal(t, "2025-12-29", allDay.Start.Format(time.DateOnly))
+		assert.Equal(t, "2026-01-04", allDay.End.Format(time.DateOnly))
+
+		tokyo, _ := time.LoadLocation("Asia/Tokyo")
+		timed := actual.Events[1]
+		assert.False(t, timed.AllDay)
+		assert.True(t, time.Date(2025, 8, 1, 9, 0, 0, 0, tokyo).Equal(timed.Start))
+		assert.True(t, time.Date(2025, 8, 1, 12, 0, 0, 0, tokyo).Equal(timed.End))
+	})
+
+	t.Run("折り返された行が連結される", func(t *testing.T) {
+		input := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\nDTSTART;VALUE=DATE:20250101\r\nSUMMARY:年末年始\r\n 休業\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+		actual, err := icalx.Parse(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "年末年始休業", actual.Events[0].Summary)
+	})
+
+	t.Run("繰り返しと除外日が読み込まれる", func(t *testing.T) {
+		input := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"UID:saturday@example.com",
+			"DTSTART;VALUE=DATE:20250104",
+			"RRULE:FREQ=WEEKLY;BYDAY=SA;COUNT=4",
+			"EXDATE;VALUE=DATE:20250111,20250118",
+			"EXDATE;VALUE=DATE:20250125",
+			"SUMMARY:土曜休業",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\n")
+
+		actual, err := icalx.Parse(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=SA;COUNT=4", actual.Events[0].RRule)
+		assert.Equal(t, 3, len(actual.Events[0].ExDates))
+	})
+
+	t.Run("終了していないVEVENTはエラーになる", func(t *testing.T) {
+		input := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a@example.com\r\n"
+
+		_, err := icalx.Parse(strings.NewReader(input))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("不正な日付はエラーになる", func(t *testing.T) {
+		input := "BEGIN:VEVENT\r\nDTSTART;VALUE=DATE:2025-01-01\r\nEND:VEVENT\r\n"
+
+		_, err := icalx.Parse(strings.NewReader(input))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEvent_Occurrences(t *testing.T) {
+	horizon := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("除外日を除いた繰り返しが展開される", func(t *testing.T) {
+		e := icalx.Event{
+			Start:   time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+			RRule:   "FREQ=WEEKLY;BYDAY=SA;COUNT=4",
+			ExDates: []time.Time{time.Date(2025, 1, 11, 0, 0, 0, 0, time.UTC)},
+		}
+
+		actual, err := e.Occurrences(horizon)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{
+			time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 18, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC),
+		}, actual)
+	})
+
+	t.Run("繰り返さないイベントは開始日だけになる", func(t *testing.T) {
+		e := icalx.Event{Start: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), AllDay: true}
+
+		actual, err := e.Occurrences(horizon)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{e.Start}, actual)
+	})
+}
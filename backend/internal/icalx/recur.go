@@ -0,0 +1,340 @@
+package icalx
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// Frequency is the FREQ part of a recurrence rule
+type Frequency string
+
+// Supported frequencies
+const (
+	FreqDaily   Frequency = "DAILY"
+	FreqWeekly  Frequency = "WEEKLY"
+	FreqMonthly Frequency = "MONTHLY"
+	FreqYearly  Frequency = "YEARLY"
+)
+
+// maxIterations guards against rules that never produce an occurrence
+const maxIterations = 100000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// WeekdayNum is an entry of BYDAY such as "MO" or "2SA"
+type WeekdayNum struct {
+	Ordinal int // Occurrence of the weekday within the month, negative values count from the end, zero for every occurrence
+	Weekday time.Weekday
+}
+
+// Recur is a recurrence rule (RFC 5545, 3.3.10).
+// Only FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and BYMONTH are supported.
+// Ordinal BYDAY entries are only allowed for MONTHLY and YEARLY rules and are always counted within the month.
+type Recur struct {
+	Freq       Frequency
+	Interval   int
+	Count      int          // Maximum number of occurrences including the first, unlimited when zero
+	Until      time.Time    // Last possible occurrence, unlimited when zero
+	ByDay      []WeekdayNum // Days of the week
+	ByMonthDay []int        // Days of the month, negative values count from the end of the month
+	ByMonth    []time.Month // Months of the year
+}
+
+// ParseRecur parses the value of an RRULE property
+func ParseRecur(s string) (*Recur, error) {
+	r := &Recur{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, xerrors.Errorf("invalid recurrence rule part: %s", part)
+		}
+
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			switch f := Frequency(strings.ToUpper(value)); f {
+			case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+				r.Freq = f
+			default:
+				return nil, xerrors.Errorf("unsupported frequency: %s", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, xerrors.Errorf("invalid interval: %s", value)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, xerrors.Errorf("invalid count: %s", value)
+			}
+			r.Count = n
+		case "UNTIL":
+			t, _, err := parseTime(value, nil)
+			if err != nil {
+				return nil, xerrors.Errorf("invalid until: %w", err)
+			}
+			r.Until = t
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				wd, err := parseWeekdayNum(v)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, xerrors.Errorf("invalid month day: %s", v)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n < 1 || n > 12 {
+					return nil, xerrors.Errorf("invalid month: %s", v)
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(n))
+			}
+		case "WKST":
+			// Weeks always start on Monday
+		default:
+			return nil, xerrors.Errorf("unsupported recurrence rule part: %s", name)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, xerrors.Errorf("recurrence rule has no frequency: %s", s)
+	}
+	if r.Freq == FreqDaily || r.Freq == FreqWeekly {
+		for _, wd := range r.ByDay {
+			if wd.Ordinal != 0 {
+				return nil, xerrors.Errorf("ordinal weekdays require a monthly or yearly frequency: %s", s)
+			}
+		}
+	}
+	return r, nil
+}
+
+// parseWeekdayNum parses a BYDAY entry such as "MO", "2SA" or "-1FR"
+func parseWeekdayNum(s string) (WeekdayNum, error) {
+	s = strings.ToUpper(s)
+	if len(s) < 2 {
+		return WeekdayNum{}, xerrors.Errorf("unsupported weekday: %s", s)
+	}
+
+	wd, ok := weekdays[s[len(s)-2:]]
+	if !ok {
+		return WeekdayNum{}, xerrors.Errorf("unsupported weekday: %s", s)
+	}
+
+	var ordinal int
+	if prefix := s[:len(s)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, xerrors.Errorf("invalid weekday ordinal: %s", s)
+		}
+		ordinal = n
+	}
+	return WeekdayNum{Ordinal: ordinal, Weekday: wd}, nil
+}
+
+// Expand returns the start of every occurrence from dtstart up to and including the horizon.
+// The first occurrence is always dtstart itself.
+func (r *Recur) Expand(dtstart, horizon time.Time) []time.Time {
+	if !r.Until.IsZero() && r.Until.Before(horizon) {
+		horizon = r.Until
+	}
+
+	if dtstart.After(horizon) {
+		return nil
+	}
+	occurrences := []time.Time{dtstart}
+
+	period := r.firstPeriod(dtstart)
+	for i := 0; i < maxIterations && !period.After(horizon); i++ {
+		for _, t := range r.candidates(period, dtstart) {
+			if !t.After(dtstart) {
+				continue
+			}
+			if t.After(horizon) || (r.Count > 0 && len(occurrences) >= r.Count) {
+				return occurrences
+			}
+			occurrences = append(occurrences, t)
+		}
+		period = r.nextPeriod(period)
+	}
+	return occurrences
+}
+
+// firstPeriod returns the start of the period containing dtstart
+func (r *Recur) firstPeriod(dtstart time.Time) time.Time {
+	y, m, d := dtstart.Date()
+	switch r.Freq {
+	case FreqWeekly:
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, dtstart.Location())
+	case FreqMonthly:
+		return time.Date(y, m, 1, 0, 0, 0, 0, dtstart.Location())
+	case FreqYearly:
+		return time.Date(y, time.January, 1, 0, 0, 0, 0, dtstart.Location())
+	default:
+		return time.Date(y, m, d, 0, 0, 0, 0, dtstart.Location())
+	}
+}
+
+// nextPeriod advances the period by the interval
+func (r *Recur) nextPeriod(period time.Time) time.Time {
+	switch r.Freq {
+	case FreqWeekly:
+		return period.AddDate(0, 0, 7*r.Interval)
+	case FreqMonthly:
+		return period.AddDate(0, r.Interval, 0)
+	case FreqYearly:
+		return period.AddDate(r.Interval, 0, 0)
+	default:
+		return period.AddDate(0, 0, r.Interval)
+	}
+}
+
+// candidates returns the occurrences inside the period in chronological order, at the time of day of dtstart
+func (r *Recur) candidates(period, dtstart time.Time) []time.Time {
+	var days []time.Time
+	switch r.Freq {
+	case FreqDaily:
+		days = []time.Time{period}
+	case FreqWeekly:
+		for i := 0; i < 7; i++ {
+			d := period.AddDate(0, 0, i)
+			if (len(r.ByDay) == 0 && d.Weekday() == dtstart.Weekday()) || r.matchesByDay(d) {
+				days = append(days, d)
+			}
+		}
+	case FreqMonthly:
+		days = r.monthDays(period, dtstart)
+	case FreqYearly:
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{dtstart.Month()}
+		}
+		for _, m := range months {
+			days = append(days, r.monthDays(time.Date(period.Year(), m, 1, 0, 0, 0, 0, period.Location()), dtstart)...)
+		}
+	}
+
+	candidates := make([]time.Time, 0, len(days))
+	for _, d := range days {
+		if !r.matches(d) {
+			continue
+		}
+		candidates = append(candidates, time.Date(d.Year(), d.Month(), d.Day(), dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, dtstart.Location()))
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	return candidates
+}
+
+// monthDays returns the days of the month starting at first selected by BYMONTHDAY or BYDAY,
+// or the day of the month of dtstart when neither is set
+func (r *Recur) monthDays(first, dtstart time.Time) []time.Time {
+	last := first.AddDate(0, 1, -1).Day()
+
+	var days []time.Time
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, n := range r.ByMonthDay {
+			if n < 0 {
+				n = last + n + 1
+			}
+			if n >= 1 && n <= last {
+				days = append(days, first.AddDate(0, 0, n-1))
+			}
+		}
+	case len(r.ByDay) > 0:
+		for i := 0; i < last; i++ {
+			d := first.AddDate(0, 0, i)
+			if r.matchesByDay(d) {
+				days = append(days, d)
+			}
+		}
+	default:
+		if dtstart.Day() <= last {
+			days = append(days, first.AddDate(0, 0, dtstart.Day()-1))
+		}
+	}
+	return days
+}
+
+// matches applies the BY* parts that limit rather than expand the occurrences of the frequency
+func (r *Recur) matches(d time.Time) bool {
+	if len(r.ByMonth) > 0 && !containsMonth(r.ByMonth, d.Month()) {
+		return false
+	}
+
+	switch r.Freq {
+	case FreqDaily:
+		if len(r.ByDay) > 0 && !r.matchesByDay(d) {
+			return false
+		}
+		return len(r.ByMonthDay) == 0 || r.matchesMonthDay(d)
+	case FreqWeekly:
+		return len(r.ByMonthDay) == 0 || r.matchesMonthDay(d)
+	default:
+		// BYMONTHDAY expands, so BYDAY limits when both are set
+		return len(r.ByMonthDay) == 0 || len(r.ByDay) == 0 || r.matchesByDay(d)
+	}
+}
+
+// matchesMonthDay reports whether the day of the month of d is listed in BYMONTHDAY
+func (r *Recur) matchesMonthDay(d time.Time) bool {
+	last := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+	for _, n := range r.ByMonthDay {
+		if n < 0 {
+			n = last + n + 1
+		}
+		if n == d.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesByDay reports whether d is selected by an entry of BYDAY, counting ordinals within the month of d
+func (r *Recur) matchesByDay(d time.Time) bool {
+	last := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+	for _, wd := range r.ByDay {
+		if wd.Weekday != d.Weekday() {
+			continue
+		}
+		switch {
+		case wd.Ordinal == 0,
+			wd.Ordinal > 0 && wd.Ordinal == (d.Day()-1)/7+1,
+			wd.Ordinal < 0 && -wd.Ordinal == (last-d.Day())/7+1:
+			return true
+		}
+	}
+	return false
+}
+
+func containsMonth(months []time.Month, m time.Month) bool {
+	for _, v := range months {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package icalx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/icalx"
+)
+
+func TestRecur_Expand(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	horizon := date(2026, 12, 31)
+
+	cases := []struct {
+		name     string
+		rule     string
+		dtstart  time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "毎日をINTERVALおきに繰り返す",
+			rule:     "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			dtstart:  date(2025, 1, 1),
+			expected: []time.Time{date(2025, 1, 1), date(2025, 1, 3), date(2025, 1, 5)},
+		},
+		{
+			name:     "毎週複数の曜日を繰り返す",
+			rule:     "FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20250113",
+			dtstart:  date(2025, 1, 3),
+			expected: []time.Time{date(2025, 1, 3), date(2025, 1, 6), date(2025, 1, 10), date(2025, 1, 13)},
+		},
+		{
+			name:     "毎月末日を繰り返す",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			dtstart:  date(2025, 1, 31),
+			expected: []time.Time{date(2025, 1, 31), date(2025, 2, 28), date(2025, 3, 31)},
+		},
+		{
+			name:     "存在しない日の月は飛ばされる",
+			rule:     "FREQ=MONTHLY;COUNT=3",
+			dtstart:  date(2025, 1, 31),
+			expected: []time.Time{date(2025, 1, 31), date(2025, 3, 31), date(2025, 5, 31)},
+		},
+		{
+			name:     "毎月第2・第4土曜日を繰り返す",
+			rule:     "FREQ=MONTHLY;BYDAY=2SA,4SA;COUNT=4",
+			dtstart:  date(2025, 1, 11),
+			expected: []time.Time{date(2025, 1, 11), date(2025, 1, 25), date(2025, 2, 8), date(2025, 2, 22)},
+		},
+		{
+			name:     "毎年11月の最終金曜日を繰り返す",
+			rule:     "FREQ=YEARLY;BYMONTH=11;BYDAY=-1FR",
+			dtstart:  date(2025, 11, 28),
+			expected: []time.Time{date(2025, 11, 28), date(2026, 11, 27)},
+		},
+		{
+			name:     "毎年同じ日を繰り返す",
+			rule:     "FREQ=YEARLY;BYMONTH=8;BYMONTHDAY=13,14",
+			dtstart:  date(2025, 8, 13),
+			expected: []time.Time{date(2025, 8, 13), date(2025, 8, 14), date(2026, 8, 13), date(2026, 8, 14)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := icalx.ParseRecur(c.rule)
+
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, r.Expand(c.dtstart, horizon))
+		})
+	}
+}
+
+func TestParseRecur_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=MONTHLY;BYDAY=6SA",
+		"FREQ=WEEKLY;BYDAY=2SA",
+		"FREQ=DAILY;BYSETPOS=1",
+	} {
+		t.Run(rule, func(t *testing.T) {
+			_, err := icalx.ParseRecur(rule)
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package icalx
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineOctets is the maximum length of a content line excluding the line break (RFC 5545, 3.1)
+const maxLineOctets = 75
+
+// textEscaper escapes TEXT property values (RFC 5545, 3.3.11)
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// Encode writes the calendar in iCalendar format
+func (c *Calendar) Encode(w io.Writer) error {
+	e := &encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + c.ProdID)
+	e.line("CALSCALE:GREGORIAN")
+	e.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		e.event(event)
+	}
+
+	e.line("END:VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// encoder writes folded content lines, keeping the first error
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(event Event) {
+	e.line("BEGIN:VEVENT")
+	e.line("UID:" + event.UID)
+	e.line("DTSTAMP:" + event.DTStamp.UTC().Format(utcDateTimeFormat))
+	e.line("DTSTART" + formatTime(event.Start, event.AllDay))
+	if !event.End.IsZero() {
+		e.line("DTEND" + formatTime(event.End, event.AllDay))
+	}
+	if event.RRule != "" {
+		e.line("RRULE:" + event.RRule)
+	}
+	for _, exDate := range event.ExDates {
+		e.line("EXDATE" + formatTime(exDate, event.AllDay))
+	}
+	e.line("SUMMARY:" + escapeText(event.Summary))
+	if event.Description != "" {
+		e.line("DESCRIPTION:" + escapeText(event.Description))
+	}
+	if len(event.Categories) > 0 {
+		categories := make([]string, 0, len(event.Categories))
+		for _, c := range event.Categories {
+			categories = append(categories, escapeText(c))
+		}
+		e.line("CATEGORIES:" + strings.Join(categories, ","))
+	}
+	if event.AllDay {
+		// All-day holidays should not block free/busy time
+		e.line("TRANSP:TRANSPARENT")
+	}
+	e.line("END:VEVENT")
+}
+
+// line writes a content line, folding it at octet boundaries without splitting UTF-8 sequences
+func (e *encoder) line(s string) {
+	if e.err != nil {
+		return
+	}
+
+	for _, l := range fold(s) {
+		if _, err := e.w.WriteString(l + "\r\n"); err != nil {
+			e.err = err
+			return
+		}
+	}
+}
+
+// fold splits a content line into physical lines of at most 75 octets.
+// Continuation lines start with a single space, which counts towards their length.
+func fold(s string) []string {
+	var lines []string
+	limit := maxLineOctets
+	prefix := ""
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		lines = append(lines, prefix+s[:cut])
+		s = s[cut:]
+		prefix = " "
+		limit = maxLineOctets - 1
+	}
+	return append(lines, prefix+s)
+}
+
+// formatTime formats a DTSTART, DTEND or EXDATE value including its parameters
+func formatTime(t time.Time, allDay bool) string {
+	if allDay {
+		return ";VALUE=DATE:" + t.Format(dateFormat)
+	}
+	return ":" + t.UTC().Format(utcDateTimeFormat)
+}
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
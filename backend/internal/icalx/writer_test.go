@@ -0,0 +1,91 @@
+package icalx_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"net.bright-room.dev/calender-api/internal/icalx"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	stamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("終日イベントが出力される", func(t *testing.T) {
+		cal := icalx.Calendar{
+			ProdID: "-//bright-room//business-calender//JA",
+			Name:   "営業カレンダー",
+			Events: []icalx.Event{
+				{
+					UID:        "20250101-national-holiday@example.com",
+					DTStamp:    stamp,
+					Start:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:        time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+					AllDay:     true,
+					Summary:    "元日",
+					Categories: []string{"national_holiday"},
+				},
+			},
+		}
+
+		var b strings.Builder
+		err := cal.Encode(&b)
+
+		assert.NoError(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//bright-room//business-calender//JA",
+			"CALSCALE:GREGORIAN",
+			"METHOD:PUBLISH",
+			"X-WR-CALNAME:営業カレンダー",
+			"BEGIN:VEVENT",
+			"UID:20250101-national-holiday@example.com",
+			"DTSTAMP:20250101T000000Z",
+			"DTSTART;VALUE=DATE:20250101",
+			"DTEND;VALUE=DATE:20250102",
+			"SUMMARY:元日",
+			"CATEGORIES:national_holiday",
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n"), b.String())
+	})
+
+	t.Run("長い行がUTF-8の文字境界で75オクテット以内に折り返される", func(t *testing.T) {
+		summary := strings.Repeat("年末年始休業", 10)
+		cal := icalx.Calendar{
+			Events: []icalx.Event{{UID: "uid", DTStamp: stamp, Start: stamp, AllDay: true, Summary: summary}},
+		}
+
+		var b strings.Builder
+		_ = cal.Encode(&b)
+
+		var unfolded string
+		for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+			assert.True(t, utf8.ValidString(line))
+
+			if strings.HasPrefix(line, " ") {
+				unfolded += line[1:]
+			} else {
+				unfolded += "\n" + line
+			}
+		}
+		assert.Contains(t, unfolded, "\nSUMMARY:"+summary+"\n")
+	})
+
+	t.Run("テキストの特殊文字がエスケープされる", func(t *testing.T) {
+		cal := icalx.Calendar{
+			Events: []icalx.Event{{UID: "uid", DTStamp: stamp, Start: stamp, AllDay: true, Summary: "棚卸し; 本社,工場\\倉庫\n終日"}},
+		}
+
+		var b strings.Builder
+		_ = cal.Encode(&b)
+
+		assert.Contains(t, b.String(), `SUMMARY:棚卸し\; 本社\,工場\\倉庫\n終日`)
+	})
+}
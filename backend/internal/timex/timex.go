@@ -24,6 +24,17 @@ func StartOfUnixEpoch() time.Time {
 	return time.Date(1970, 1, 1, 0, 0, 0, 0, JST)
 }
 
+// Date returns midnight of the given day in JST
+func Date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, JST)
+}
+
+// DateOf returns midnight in JST of the calendar day of t in its own location.
+// Dates read from date columns are returned at midnight UTC, so this keeps their day unchanged.
+func DateOf(t time.Time) time.Time {
+	return Date(t.Year(), t.Month(), t.Day())
+}
+
 func NowDate() time.Time {
 	now := time.Now()
 	return now.Truncate(DAY)
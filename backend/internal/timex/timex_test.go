@@ -30,3 +30,13 @@ func TestTimeRange_DatesUntil(t *testing.T) {
 		assert.Equal(t, 31, len(actual))
 	})
 }
+
+func TestDateOf(t *testing.T) {
+	t.Run("UTCの日付がJSTの同じ日付になる", func(t *testing.T) {
+		utc := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		actual := timex.DateOf(utc)
+
+		assert.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, timex.JST), actual)
+	})
+}
@@ -0,0 +1,5 @@
+delete from calender.closed_days where calendar_id <> 'default';
+alter table calender.closed_days drop constraint closed_days_pkey;
+alter table calender.closed_days add primary key (date);
+alter table calender.closed_days drop column calendar_id;
+drop table if exists calender.calendars;
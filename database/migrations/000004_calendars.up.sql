@@ -0,0 +1,11 @@
+create table if not exists calender.calendars (
+    id   varchar(30) not null primary key,
+    name varchar(50) not null
+);
+insert into calender.calendars (id, name) values ('default', '本社');
+
+alter table calender.closed_days add column calendar_id varchar(30) not null default 'default'
+    references calender.calendars (id);
+alter table calender.closed_days alter column calendar_id drop default;
+alter table calender.closed_days drop constraint closed_days_pkey;
+alter table calender.closed_days add primary key (calendar_id, date);
@@ -0,0 +1,2 @@
+drop table if exists calender.working_day_overrides;
+alter table calender.calendars drop column parent_id;
@@ -0,0 +1,10 @@
+alter table calender.calendars add column parent_id varchar(30)
+    references calender.calendars (id)
+    check (parent_id <> id);
+
+create table if not exists calender.working_day_overrides (
+    calendar_id varchar(30) not null references calender.calendars (id),
+    date        date        not null,
+    summary     varchar(50) not null,
+    primary key (calendar_id, date)
+);
@@ -0,0 +1,2 @@
+drop table if exists calender.closed_day_rule_exceptions;
+drop table if exists calender.closed_day_rules;
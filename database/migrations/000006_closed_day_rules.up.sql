@@ -0,0 +1,21 @@
+create table if not exists calender.closed_day_rules (
+    id                     bigserial    not null primary key,
+    calendar_id            varchar(30)  not null references calender.calendars (id),
+    summary                varchar(50)  not null,
+    starts_on              date         not null,
+    rrule                  varchar(200) not null,
+    duration_days          smallint     not null default 1 check (duration_days >= 1),
+    after_national_holiday boolean      not null default false
+);
+create index if not exists closed_day_rules_calendar_id_idx on calender.closed_day_rules (calendar_id);
+
+comment on column calender.closed_day_rules.starts_on is 'DTSTART of the rule, the first occurrence unless after_national_holiday is set';
+comment on column calender.closed_day_rules.rrule is 'RRULE value (RFC 5545) such as FREQ=MONTHLY;BYDAY=2SA,4SA';
+comment on column calender.closed_day_rules.duration_days is 'Number of consecutive days closed from each occurrence';
+comment on column calender.closed_day_rules.after_national_holiday is 'Close on the first occurrence after each national holiday instead of every occurrence';
+
+create table if not exists calender.closed_day_rule_exceptions (
+    rule_id bigint not null references calender.closed_day_rules (id) on delete cascade,
+    date    date   not null,
+    primary key (rule_id, date)
+);
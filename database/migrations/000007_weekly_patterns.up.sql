@@ -0,0 +1,8 @@
+create table if not exists calender.weekly_patterns (
+    calendar_id      varchar(30) not null references calender.calendars (id),
+    effective_from   date        not null,
+    working_weekdays smallint    not null check (working_weekdays between 0 and 127),
+    primary key (calendar_id, effective_from)
+);
+
+comment on column calender.weekly_patterns.working_weekdays is 'Bit set of working days of the week, bit 0 being Sunday and bit 6 Saturday';
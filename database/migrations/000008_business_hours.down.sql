@@ -0,0 +1,3 @@
+drop table if exists calender.business_hour_exceptions;
+drop table if exists calender.business_hours;
+alter table calender.calendars drop column time_zone;
@@ -0,0 +1,19 @@
+alter table calender.calendars add column time_zone varchar(64) not null default 'Asia/Tokyo';
+
+create table if not exists calender.business_hours (
+    calendar_id varchar(30) not null references calender.calendars (id),
+    weekday     smallint    not null check (weekday between 0 and 6),
+    opens_at    time        not null,
+    closes_at   time        not null check (closes_at > opens_at),
+    primary key (calendar_id, weekday, opens_at)
+);
+
+comment on column calender.business_hours.weekday is 'Day of the week, 0 being Sunday and 6 Saturday';
+
+create table if not exists calender.business_hour_exceptions (
+    calendar_id varchar(30) not null references calender.calendars (id),
+    date        date        not null,
+    opens_at    time        not null,
+    closes_at   time        not null check (closes_at > opens_at),
+    primary key (calendar_id, date, opens_at)
+);
@@ -0,0 +1,3 @@
+alter table calender.closed_days drop constraint closed_days_partial_check;
+alter table calender.closed_days drop column closes_at;
+alter table calender.closed_days drop column opens_at;
@@ -0,0 +1,7 @@
+alter table calender.closed_days add column opens_at time;
+alter table calender.closed_days add column closes_at time;
+alter table calender.closed_days add constraint closed_days_partial_check
+    check (opens_at is null or closes_at is null or opens_at < closes_at);
+
+comment on column calender.closed_days.opens_at is 'For a partial closure, the time business starts; null when it starts as usual';
+comment on column calender.closed_days.closes_at is 'For a partial closure, the time business ends; null when it ends as usual';
@@ -0,0 +1 @@
+comment on table calender.working_day_overrides is null;
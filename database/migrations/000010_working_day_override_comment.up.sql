@@ -0,0 +1 @@
+comment on table calender.working_day_overrides is 'Dates a calendar works on even when they are national holidays, weekends or closed days inherited from the parent calendar';
@@ -0,0 +1,4 @@
+alter table calender.closed_day_rules drop column category;
+alter table calender.closed_days drop column category;
+alter table calender.national_holiday drop column category;
+drop type if exists calender.holiday_category;
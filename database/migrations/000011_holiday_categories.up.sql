@@ -0,0 +1,10 @@
+create type calender.holiday_category as enum ('statutory', 'substitute', 'citizens', 'special', 'company');
+
+alter table calender.national_holiday add column category calender.holiday_category not null default 'statutory';
+update calender.national_holiday set category = 'substitute' where summary = '振替休日';
+update calender.national_holiday set category = 'citizens' where summary = '国民の休日';
+
+alter table calender.closed_days add column category calender.holiday_category not null default 'company';
+alter table calender.closed_day_rules add column category calender.holiday_category not null default 'company';
+
+comment on type calender.holiday_category is 'statutory: 国民の祝日, substitute: 振替休日, citizens: 国民の休日, special: 特別な休日, company: 会社の休業日';
@@ -0,0 +1,3 @@
+alter table calender.closed_day_rules drop column names;
+alter table calender.closed_days drop column names;
+alter table calender.national_holiday drop column names;
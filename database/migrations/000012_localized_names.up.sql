@@ -0,0 +1,7 @@
+alter table calender.national_holiday add column names jsonb not null default '{}';
+alter table calender.closed_days add column names jsonb not null default '{}';
+alter table calender.closed_day_rules add column names jsonb not null default '{}';
+
+comment on column calender.national_holiday.names is 'Names by language, e.g. {"en": "New Year''s Day"}; summary is the Japanese name';
+comment on column calender.closed_days.names is 'Names by language, e.g. {"en": "Year-end holidays"}; summary is the Japanese name';
+comment on column calender.closed_day_rules.names is 'Names by language of the closed days of the rule; summary is the Japanese name';
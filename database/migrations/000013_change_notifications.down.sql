@@ -0,0 +1,7 @@
+drop trigger if exists weekly_patterns_changed on calender.weekly_patterns;
+drop trigger if exists working_day_overrides_changed on calender.working_day_overrides;
+drop trigger if exists closed_day_rule_exceptions_changed on calender.closed_day_rule_exceptions;
+drop trigger if exists closed_day_rules_changed on calender.closed_day_rules;
+drop trigger if exists closed_days_changed on calender.closed_days;
+drop trigger if exists national_holiday_changed on calender.national_holiday;
+drop function if exists calender.notify_calendar_changed();
@@ -0,0 +1,27 @@
+create or replace function calender.notify_calendar_changed() returns trigger as $$
+begin
+    perform pg_notify('calender_changed', tg_table_name);
+    return null;
+end;
+$$ language plpgsql;
+
+create trigger national_holiday_changed
+    after insert or update or delete or truncate on calender.national_holiday
+    for each statement execute function calender.notify_calendar_changed();
+create trigger closed_days_changed
+    after insert or update or delete or truncate on calender.closed_days
+    for each statement execute function calender.notify_calendar_changed();
+create trigger closed_day_rules_changed
+    after insert or update or delete or truncate on calender.closed_day_rules
+    for each statement execute function calender.notify_calendar_changed();
+create trigger closed_day_rule_exceptions_changed
+    after insert or update or delete or truncate on calender.closed_day_rule_exceptions
+    for each statement execute function calender.notify_calendar_changed();
+create trigger working_day_overrides_changed
+    after insert or update or delete or truncate on calender.working_day_overrides
+    for each statement execute function calender.notify_calendar_changed();
+create trigger weekly_patterns_changed
+    after insert or update or delete or truncate on calender.weekly_patterns
+    for each statement execute function calender.notify_calendar_changed();
+
+comment on function calender.notify_calendar_changed() is 'Notifies the calender_changed channel with the table name, so that API instances invalidate their business-day caches';
@@ -0,0 +1,10 @@
+drop function if exists calender.business_days_between(date, date, varchar);
+drop function if exists calender.add_business_days(date, int, varchar);
+drop function if exists calender.is_business_day(date, varchar);
+drop function if exists calender.business_day_statuses(varchar, date, date);
+drop function if exists calender.closed_day_rule_dates(bigint, date, date);
+drop function if exists calender.expand_rrule(text, date, date);
+drop function if exists calender.rrule_month_days(date, date, text[], int[]);
+drop function if exists calender.rrule_matches_month_day(date, int[]);
+drop function if exists calender.rrule_matches_by_day(date, text[]);
+drop function if exists calender.calendar_chain(varchar);
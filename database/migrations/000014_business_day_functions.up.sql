@@ -0,0 +1,352 @@
+-- Business-day logic for reporting in SQL. These functions follow the Go implementation
+-- (BusinessCalendar, ClosedDayRule, icalx.Recur and service.ResolveDays) and are checked against it
+-- with the shared fixture in backend/internal/calender/infrastructure/datasource/testdata.
+
+create or replace function calender.calendar_chain(calendar varchar) returns varchar[]
+    language plpgsql stable
+as $$
+declare
+    chain      varchar[] := '{}';
+    current_id varchar   := calendar;
+    parent     varchar;
+begin
+    while current_id is not null loop
+        if current_id = any (chain) then
+            raise exception 'calendar inherits from itself: %', calendar;
+        end if;
+        select c.parent_id into parent from calender.calendars c where c.id = current_id;
+        if not found then
+            raise exception 'calendar not found: %', current_id using errcode = 'no_data_found';
+        end if;
+        chain := array_prepend(current_id, chain);
+        current_id := parent;
+    end loop;
+    return chain;
+end;
+$$;
+
+create or replace function calender.rrule_matches_by_day(d date, by_day text[]) returns boolean
+    language sql immutable
+as $$
+    select exists (
+        select 1
+        from unnest(by_day) as e
+        where right(e, 2) = (array ['SU', 'MO', 'TU', 'WE', 'TH', 'FR', 'SA'])[extract(dow from d)::int + 1]
+          and case
+                  when length(e) = 2 then true
+                  when left(e, -2)::int > 0 then left(e, -2)::int = (extract(day from d)::int - 1) / 7 + 1
+                  else -left(e, -2)::int = (extract(day from (date_trunc('month', d) + interval '1 month - 1 day'))::int
+                                                - extract(day from d)::int) / 7 + 1
+              end
+    );
+$$;
+
+create or replace function calender.rrule_matches_month_day(d date, by_month_day int[]) returns boolean
+    language sql immutable
+as $$
+    select exists (
+        select 1
+        from unnest(by_month_day) as n
+        where case
+                  when n < 0 then extract(day from (date_trunc('month', d) + interval '1 month - 1 day'))::int + n + 1
+                  else n
+              end = extract(day from d)::int
+    );
+$$;
+
+create or replace function calender.rrule_month_days(month_start date, dtstart date, by_day text[], by_month_day int[]) returns setof date
+    language plpgsql immutable
+as $$
+declare
+    last_day_of_month int := extract(day from (month_start + interval '1 month - 1 day'))::int;
+    n                 int;
+begin
+    if cardinality(by_month_day) > 0 then
+        foreach n in array by_month_day loop
+            if n < 0 then
+                n := last_day_of_month + n + 1;
+            end if;
+            if n between 1 and last_day_of_month then
+                return next month_start + n - 1;
+            end if;
+        end loop;
+    elsif cardinality(by_day) > 0 then
+        return query
+            select month_start + i
+            from generate_series(0, last_day_of_month - 1) as i
+            where calender.rrule_matches_by_day(month_start + i, by_day);
+    elsif extract(day from dtstart)::int <= last_day_of_month then
+        return next month_start + extract(day from dtstart)::int - 1;
+    end if;
+end;
+$$;
+
+-- expand_rrule returns the start of every occurrence of the rule from dtstart up to and including the horizon,
+-- supporting the same parts as the API: FREQ, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and BYMONTH
+create or replace function calender.expand_rrule(rrule text, dtstart date, horizon date) returns setof date
+    language plpgsql immutable
+as $$
+declare
+    freq         text;
+    step         int    := 1;
+    max_count    int    := 0;
+    by_day       text[] := '{}';
+    by_month_day int[]  := '{}';
+    by_month     int[]  := '{}';
+    until_day    date;
+    part         text;
+    value        text;
+    period       date;
+    candidates   date[];
+    candidate    date;
+    emitted      int    := 1;
+    iterations   int    := 0;
+begin
+    foreach part in array string_to_array(rrule, ';') loop
+        value := upper(split_part(part, '=', 2));
+        case upper(split_part(part, '=', 1))
+            when 'FREQ' then freq := value;
+            when 'INTERVAL' then step := value::int;
+            when 'COUNT' then max_count := value::int;
+            when 'UNTIL' then
+                if value like '%Z' then
+                    until_day := ((to_timestamp(left(value, 15), 'YYYYMMDD"T"HH24MISS')::timestamp at time zone 'UTC')
+                        at time zone 'Asia/Tokyo')::date;
+                else
+                    until_day := to_date(left(value, 8), 'YYYYMMDD');
+                end if;
+                if until_day < horizon then
+                    horizon := until_day;
+                end if;
+            when 'BYDAY' then by_day := string_to_array(value, ',');
+            when 'BYMONTHDAY' then by_month_day := string_to_array(value, ',')::int[];
+            when 'BYMONTH' then by_month := string_to_array(value, ',')::int[];
+            else null; -- WKST, weeks always start on Monday
+        end case;
+    end loop;
+
+    if dtstart > horizon then
+        return;
+    end if;
+    return next dtstart;
+
+    period := case freq
+                  when 'WEEKLY' then dtstart - (extract(isodow from dtstart)::int - 1)
+                  when 'MONTHLY' then date_trunc('month', dtstart)::date
+                  when 'YEARLY' then date_trunc('year', dtstart)::date
+                  else dtstart
+              end;
+    while iterations < 100000 and period <= horizon loop
+        iterations := iterations + 1;
+
+        candidates := case freq
+            when 'DAILY' then array [period]
+            when 'WEEKLY' then array(
+                select period + i
+                from generate_series(0, 6) as i
+                where (cardinality(by_day) = 0 and extract(dow from period + i) = extract(dow from dtstart))
+                   or calender.rrule_matches_by_day(period + i, by_day))
+            when 'MONTHLY' then array(
+                select d from calender.rrule_month_days(period, dtstart, by_day, by_month_day) as d)
+            else array(
+                select d
+                from unnest(case when cardinality(by_month) > 0 then by_month else array [extract(month from dtstart)::int] end) as m,
+                     calender.rrule_month_days(make_date(extract(year from period)::int, m, 1), dtstart, by_day, by_month_day) as d)
+        end;
+
+        for candidate in
+            select d
+            from unnest(candidates) as d
+            where (cardinality(by_month) = 0 or extract(month from d)::int = any (by_month))
+              and case freq
+                      when 'DAILY' then (cardinality(by_day) = 0 or calender.rrule_matches_by_day(d, by_day))
+                          and (cardinality(by_month_day) = 0 or calender.rrule_matches_month_day(d, by_month_day))
+                      when 'WEEKLY' then cardinality(by_month_day) = 0 or calender.rrule_matches_month_day(d, by_month_day)
+                      else cardinality(by_month_day) = 0 or cardinality(by_day) = 0 or calender.rrule_matches_by_day(d, by_day)
+                  end
+            order by d
+        loop
+            continue when candidate <= dtstart;
+            if candidate > horizon or (max_count > 0 and emitted >= max_count) then
+                return;
+            end if;
+            return next candidate;
+            emitted := emitted + 1;
+        end loop;
+
+        period := case freq
+                      when 'WEEKLY' then period + 7 * step
+                      when 'MONTHLY' then (period + make_interval(months => step))::date
+                      when 'YEARLY' then (period + make_interval(years => step))::date
+                      else period + step
+                  end;
+    end loop;
+end;
+$$;
+
+-- closed_day_rule_dates returns the closed days of the rule from first_day to last_day
+create or replace function calender.closed_day_rule_dates(closed_day_rule_id bigint, first_day date, last_day date) returns setof date
+    language sql stable
+as $$
+    with target_rule as materialized (
+        select * from calender.closed_day_rules r where r.id = closed_day_rule_id
+    ),
+    occurrences as materialized (
+        select o.occurrence
+        from target_rule r
+             cross join lateral calender.expand_rrule(r.rrule, r.starts_on, last_day) as o(occurrence)
+    ),
+    selected as (
+        select o.occurrence
+        from occurrences o, target_rule r
+        where not r.after_national_holiday
+        union
+        select (select min(o.occurrence) from occurrences o where o.occurrence > h.date)
+        from calender.national_holiday h, target_rule r
+        where r.after_national_holiday
+          and h.date between (first_day - interval '1 year')::date and last_day
+    )
+    select distinct s.occurrence + i
+    from selected s, target_rule r, generate_series(0, greatest(r.duration_days, 1) - 1) as i
+    where s.occurrence + i between first_day and last_day
+      and not exists (
+        select 1 from calender.closed_day_rule_exceptions e where e.rule_id = r.id and e.date = s.occurrence + i
+    );
+$$;
+
+-- business_day_statuses returns the status of every date from first_day to last_day, with the kinds of the API
+create or replace function calender.business_day_statuses(calendar varchar, first_day date, last_day date)
+    returns table (date date, kind text, is_business_day boolean)
+    language sql stable
+as $$
+    with layers as materialized (
+        select l.id, l.depth
+        from unnest(calender.calendar_chain(calendar)) with ordinality as l(id, depth)
+    ),
+    -- A later layer replaces earlier ones, and within a layer closed days replace working-day overrides
+    events as (
+        select o.date, l.depth, 0 as priority, null::time as opens_at, null::time as closes_at
+        from calender.working_day_overrides o
+             join layers l on l.id = o.calendar_id
+        where o.date between first_day and last_day
+        union all
+        select d.date, l.depth, 1, null, null
+        from calender.closed_day_rules r
+             join layers l on l.id = r.calendar_id
+             cross join lateral calender.closed_day_rule_dates(r.id, first_day, last_day) as d(date)
+        union all
+        select c.date, l.depth, 2, c.opens_at, c.closes_at
+        from calender.closed_days c
+             join layers l on l.id = c.calendar_id
+        where c.date between first_day and last_day
+    ),
+    resolved as (
+        select distinct on (e.date) e.*
+        from events e
+        order by e.date, e.depth desc, e.priority desc
+    ),
+    -- Weekly patterns come from the last layer that has any
+    pattern_layer as (
+        select l.id
+        from layers l
+        where exists (select 1 from calender.weekly_patterns p where p.calendar_id = l.id)
+        order by l.depth desc
+        limit 1
+    ),
+    days as (
+        select d::date as date,
+               coalesce((select p.working_weekdays
+                         from calender.weekly_patterns p
+                              join pattern_layer pl on pl.id = p.calendar_id
+                         where p.effective_from <= d::date
+                         order by p.effective_from desc
+                         limit 1), 62) >> extract(dow from d)::int & 1 = 1 as working
+        from generate_series(first_day, last_day, interval '1 day') as d
+    ),
+    statuses as (
+        select d.date,
+               case
+                   when r.priority = 0 then 'working_day_override'
+                   when h.date is not null then 'national_holiday'
+                   when r.priority > 0 and coalesce(r.opens_at, '00:00') = '00:00' and coalesce(r.closes_at, '00:00') = '00:00' then 'closed_day'
+                   when r.priority > 0 and d.working then 'short_day'
+                   when not d.working then 'weekend'
+                   else 'business_day'
+               end as kind
+        from days d
+             left join resolved r on r.date = d.date
+             left join calender.national_holiday h on h.date = d.date
+    )
+    select s.date, s.kind, s.kind in ('business_day', 'short_day', 'working_day_override')
+    from statuses s
+    order by s.date;
+$$;
+
+create or replace function calender.is_business_day(target date, calendar varchar default 'default') returns boolean
+    language sql stable
+as $$
+    select s.is_business_day from calender.business_day_statuses(calendar, target, target) s;
+$$;
+
+-- add_business_days returns the date n business days after the target, or before it when n is negative.
+-- The target itself is returned when n is zero.
+create or replace function calender.add_business_days(target date, n int, calendar varchar default 'default') returns date
+    language plpgsql stable
+as $$
+declare
+    remaining   int  := abs(n);
+    window_days int  := greatest(abs(n) * 2, 31);
+    searched    int  := 0;
+    cursor_day  date := target;
+    first_day   date;
+    last_day    date;
+    found_days  date[];
+    found_count int;
+begin
+    if n = 0 then
+        return target;
+    end if;
+
+    loop
+        if n > 0 then
+            first_day := cursor_day + 1;
+            last_day := cursor_day + window_days;
+        else
+            first_day := cursor_day - window_days;
+            last_day := cursor_day - 1;
+        end if;
+
+        select array_agg(s.date order by s.date) into found_days
+        from calender.business_day_statuses(calendar, first_day, last_day) s
+        where s.is_business_day;
+        found_count := coalesce(cardinality(found_days), 0);
+        if found_count >= remaining then
+            return case when n > 0 then found_days[remaining] else found_days[found_count - remaining + 1] end;
+        end if;
+
+        remaining := remaining - found_count;
+        cursor_day := case when n > 0 then last_day else first_day end;
+        searched := searched + window_days;
+        if searched > 366 * 30 then
+            raise exception 'no business day within % days of %', searched, target;
+        end if;
+    end loop;
+end;
+$$;
+
+-- business_days_between returns the number of business days after from_day up to and including to_day,
+-- negative when to_day is before from_day
+create or replace function calender.business_days_between(from_day date, to_day date, calendar varchar default 'default') returns int
+    language sql stable
+as $$
+    select case
+               when from_day <= to_day then
+                   (select count(*) from calender.business_day_statuses(calendar, from_day + 1, to_day) s where s.is_business_day)
+               else
+                   -(select count(*) from calender.business_day_statuses(calendar, to_day + 1, from_day) s where s.is_business_day)
+           end::int;
+$$;
+
+comment on function calender.is_business_day(date, varchar) is 'Whether the date is a business day of the calendar, short days included';
+comment on function calender.add_business_days(date, int, varchar) is 'The date n business days after the date, before it when n is negative';
+comment on function calender.business_days_between(date, date, varchar) is 'Business days after the first date up to and including the second, negative when reversed';
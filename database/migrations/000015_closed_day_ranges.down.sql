@@ -0,0 +1,85 @@
+alter table calender.closed_days drop constraint closed_days_no_overlap;
+alter table calender.closed_days drop constraint closed_days_period_check;
+alter table calender.closed_days add column date date;
+
+insert into calender.closed_days (calendar_id, summary, opens_at, closes_at, category, names, period, date)
+select c.calendar_id, c.summary, c.opens_at, c.closes_at, c.category, c.names, c.period, d::date
+from calender.closed_days c
+     cross join lateral generate_series(lower(c.period) + 1, upper(c.period) - 1, interval '1 day') as d;
+update calender.closed_days set date = lower(period) where date is null;
+
+alter table calender.closed_days drop constraint closed_days_pkey;
+alter table calender.closed_days drop column id;
+alter table calender.closed_days drop column period;
+alter table calender.closed_days alter column date set not null;
+alter table calender.closed_days add primary key (calendar_id, date);
+
+-- btree_gist is left installed, as it may have been installed before
+
+-- business_day_statuses returns the status of every date from first_day to last_day, with the kinds of the API
+create or replace function calender.business_day_statuses(calendar varchar, first_day date, last_day date)
+    returns table (date date, kind text, is_business_day boolean)
+    language sql stable
+as $$
+    with layers as materialized (
+        select l.id, l.depth
+        from unnest(calender.calendar_chain(calendar)) with ordinality as l(id, depth)
+    ),
+    -- A later layer replaces earlier ones, and within a layer closed days replace working-day overrides
+    events as (
+        select o.date, l.depth, 0 as priority, null::time as opens_at, null::time as closes_at
+        from calender.working_day_overrides o
+             join layers l on l.id = o.calendar_id
+        where o.date between first_day and last_day
+        union all
+        select d.date, l.depth, 1, null, null
+        from calender.closed_day_rules r
+             join layers l on l.id = r.calendar_id
+             cross join lateral calender.closed_day_rule_dates(r.id, first_day, last_day) as d(date)
+        union all
+        select c.date, l.depth, 2, c.opens_at, c.closes_at
+        from calender.closed_days c
+             join layers l on l.id = c.calendar_id
+        where c.date between first_day and last_day
+    ),
+    resolved as (
+        select distinct on (e.date) e.*
+        from events e
+        order by e.date, e.depth desc, e.priority desc
+    ),
+    -- Weekly patterns come from the last layer that has any
+    pattern_layer as (
+        select l.id
+        from layers l
+        where exists (select 1 from calender.weekly_patterns p where p.calendar_id = l.id)
+        order by l.depth desc
+        limit 1
+    ),
+    days as (
+        select d::date as date,
+               coalesce((select p.working_weekdays
+                         from calender.weekly_patterns p
+                              join pattern_layer pl on pl.id = p.calendar_id
+                         where p.effective_from <= d::date
+                         order by p.effective_from desc
+                         limit 1), 62) >> extract(dow from d)::int & 1 = 1 as working
+        from generate_series(first_day, last_day, interval '1 day') as d
+    ),
+    statuses as (
+        select d.date,
+               case
+                   when r.priority = 0 then 'working_day_override'
+                   when h.date is not null then 'national_holiday'
+                   when r.priority > 0 and coalesce(r.opens_at, '00:00') = '00:00' and coalesce(r.closes_at, '00:00') = '00:00' then 'closed_day'
+                   when r.priority > 0 and d.working then 'short_day'
+                   when not d.working then 'weekend'
+                   else 'business_day'
+               end as kind
+        from days d
+             left join resolved r on r.date = d.date
+             left join calender.national_holiday h on h.date = d.date
+    )
+    select s.date, s.kind, s.kind in ('business_day', 'short_day', 'working_day_override')
+    from statuses s
+    order by s.date;
+$$;
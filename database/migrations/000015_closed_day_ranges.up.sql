@@ -0,0 +1,104 @@
+create extension if not exists btree_gist;
+
+alter table calender.closed_days add column id bigserial;
+alter table calender.closed_days add column period daterange;
+
+-- Consecutive dates with the same summary, category, names and hours become a single closure
+with islands as (
+    select c.calendar_id, c.date, c.summary, c.category, c.names, c.opens_at, c.closes_at,
+           c.date - (row_number() over (partition by c.calendar_id, c.summary, c.category, c.names, c.opens_at, c.closes_at
+                                        order by c.date))::int as island
+    from calender.closed_days c
+),
+closures as (
+    select i.calendar_id, min(i.date) as starts_on, max(i.date) as ends_on
+    from islands i
+    group by i.calendar_id, i.summary, i.category, i.names, i.opens_at, i.closes_at, i.island
+)
+update calender.closed_days c
+set period = daterange(cl.starts_on, cl.ends_on, '[]')
+from closures cl
+where c.calendar_id = cl.calendar_id
+  and c.date between cl.starts_on and cl.ends_on;
+
+delete from calender.closed_days where date <> lower(period);
+
+alter table calender.closed_days drop constraint closed_days_pkey;
+alter table calender.closed_days drop column date;
+alter table calender.closed_days alter column period set not null;
+alter table calender.closed_days add primary key (id);
+alter table calender.closed_days add constraint closed_days_period_check
+    check (not isempty(period) and not lower_inf(period) and not upper_inf(period));
+alter table calender.closed_days add constraint closed_days_no_overlap
+    exclude using gist (calendar_id with =, period with &&);
+
+comment on column calender.closed_days.period is 'Days of the closure, e.g. [2025-08-09,2025-08-18) for a nine-day summer closure';
+
+-- business_day_statuses returns the status of every date from first_day to last_day, with the kinds of the API
+create or replace function calender.business_day_statuses(calendar varchar, first_day date, last_day date)
+    returns table (date date, kind text, is_business_day boolean)
+    language sql stable
+as $$
+    with layers as materialized (
+        select l.id, l.depth
+        from unnest(calender.calendar_chain(calendar)) with ordinality as l(id, depth)
+    ),
+    -- A later layer replaces earlier ones, and within a layer closed days replace working-day overrides
+    events as (
+        select o.date, l.depth, 0 as priority, null::time as opens_at, null::time as closes_at
+        from calender.working_day_overrides o
+             join layers l on l.id = o.calendar_id
+        where o.date between first_day and last_day
+        union all
+        select d.date, l.depth, 1, null, null
+        from calender.closed_day_rules r
+             join layers l on l.id = r.calendar_id
+             cross join lateral calender.closed_day_rule_dates(r.id, first_day, last_day) as d(date)
+        union all
+        select g::date, l.depth, 2, c.opens_at, c.closes_at
+        from calender.closed_days c
+             join layers l on l.id = c.calendar_id
+             cross join lateral generate_series(greatest(lower(c.period), first_day), least(upper(c.period) - 1, last_day), interval '1 day') as g
+        where c.period && daterange(first_day, last_day, '[]')
+    ),
+    resolved as (
+        select distinct on (e.date) e.*
+        from events e
+        order by e.date, e.depth desc, e.priority desc
+    ),
+    -- Weekly patterns come from the last layer that has any
+    pattern_layer as (
+        select l.id
+        from layers l
+        where exists (select 1 from calender.weekly_patterns p where p.calendar_id = l.id)
+        order by l.depth desc
+        limit 1
+    ),
+    days as (
+        select d::date as date,
+               coalesce((select p.working_weekdays
+                         from calender.weekly_patterns p
+                              join pattern_layer pl on pl.id = p.calendar_id
+                         where p.effective_from <= d::date
+                         order by p.effective_from desc
+                         limit 1), 62) >> extract(dow from d)::int & 1 = 1 as working
+        from generate_series(first_day, last_day, interval '1 day') as d
+    ),
+    statuses as (
+        select d.date,
+               case
+                   when r.priority = 0 then 'working_day_override'
+                   when h.date is not null then 'national_holiday'
+                   when r.priority > 0 and coalesce(r.opens_at, '00:00') = '00:00' and coalesce(r.closes_at, '00:00') = '00:00' then 'closed_day'
+                   when r.priority > 0 and d.working then 'short_day'
+                   when not d.working then 'weekend'
+                   else 'business_day'
+               end as kind
+        from days d
+             left join resolved r on r.date = d.date
+             left join calender.national_holiday h on h.date = d.date
+    )
+    select s.date, s.kind, s.kind in ('business_day', 'short_day', 'working_day_override')
+    from statuses s
+    order by s.date;
+$$;
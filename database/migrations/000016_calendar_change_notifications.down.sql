@@ -0,0 +1,3 @@
+drop trigger if exists calendars_truncated on calender.calendars;
+drop trigger if exists calendars_changed on calender.calendars;
+drop function if exists calender.notify_calendar_hierarchy_changed();
@@ -0,0 +1,26 @@
+create or replace function calender.notify_calendar_hierarchy_changed() returns trigger as $$
+declare
+    ids text;
+begin
+    -- The calendar and every calendar inheriting from it, before and after the change
+    with recursive affected(id) as (
+        select id from (values (case when tg_op <> 'INSERT' then old.id end), (case when tg_op <> 'DELETE' then new.id end)) as changed(id)
+        where id is not null
+        union
+        select c.id from calender.calendars c join affected a on c.parent_id = a.id
+    )
+    select string_agg(id, ',' order by id) into ids from affected;
+
+    perform pg_notify('calender_changed', tg_table_name || ':' || ids);
+    return null;
+end;
+$$ language plpgsql;
+
+create trigger calendars_changed
+    after insert or update or delete on calender.calendars
+    for each row execute function calender.notify_calendar_hierarchy_changed();
+create trigger calendars_truncated
+    after truncate on calender.calendars
+    for each statement execute function calender.notify_calendar_changed();
+
+comment on function calender.notify_calendar_hierarchy_changed() is 'Notifies the calender_changed channel with the table name and the changed calendar and its descendants, as a new parent changes every inherited business day';
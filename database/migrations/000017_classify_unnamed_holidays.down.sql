@@ -0,0 +1,2 @@
+update calender.national_holiday set category = 'statutory' where summary = '休日';
+drop function if exists calender.classify_national_holidays();
@@ -0,0 +1,29 @@
+-- The Cabinet Office CSV names both substitute and citizens' holidays "休日", so 000011 left them statutory.
+-- A holiday ending a run of holidays that includes a Sunday is a substitute holiday, and one between two
+-- holidays is a citizens' holiday.
+create or replace function calender.classify_national_holidays() returns void
+    language sql
+as $$
+    update calender.national_holiday h
+    set category = 'substitute'
+    where h.summary = '休日'
+      and h.category = 'statutory'
+      and exists (
+          select 1
+          from calender.national_holiday s
+          where extract(isodow from s.date) = 7
+            and s.date between h.date - 7 and h.date - 1
+            and (select count(*) from calender.national_holiday r where r.date >= s.date and r.date < h.date) = h.date - s.date
+      );
+
+    update calender.national_holiday h
+    set category = 'citizens'
+    where h.summary = '休日'
+      and h.category = 'statutory'
+      and exists (select 1 from calender.national_holiday p where p.date = h.date - 1)
+      and exists (select 1 from calender.national_holiday n where n.date = h.date + 1);
+$$;
+
+select calender.classify_national_holidays();
+
+comment on function calender.classify_national_holidays() is 'Sets the category of the holidays named 休日 from the surrounding holidays. Run it after loading the Cabinet Office CSV';